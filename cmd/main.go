@@ -7,48 +7,168 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"os"
 	"os/signal"
 	"path/filepath"
-	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 
 	"syscall"
 	"time"
 
-	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
 
 	validator10 "github.com/go-playground/validator/v10"
 	common_http "github.com/kirillismad/go-url-shortener/internal/apps/common/http"
+	links_grpc "github.com/kirillismad/go-url-shortener/internal/apps/links/grpc"
 	links_http "github.com/kirillismad/go-url-shortener/internal/apps/links/http"
 	links_usecase "github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/apps/openapi"
+	"github.com/kirillismad/go-url-shortener/internal/apps/ui"
+	webhooks_http "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/http"
+	webhooks_usecase "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	workspaces_http "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/http"
+	workspaces_usecase "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	linksv1 "github.com/kirillismad/go-url-shortener/internal/pb/links/v1"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/events"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/geoip"
+	grpcx "github.com/kirillismad/go-url-shortener/internal/pkg/grpc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/health"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/jwt"
 	"github.com/kirillismad/go-url-shortener/internal/pkg/repo"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/shortid"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/signature"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/throttle"
 	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/webhook"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/worker"
+	"github.com/kirillismad/go-url-shortener/migrations"
 	"github.com/kirillismad/go-url-shortener/pkg/config"
+	"google.golang.org/grpc"
+	grpc_health "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 type Config struct {
 	Server struct {
 		Host            string        `env:"HOST" yaml:"host" validate:"required"`
 		Port            uint          `env:"PORT" yaml:"port" validate:"required"`
-		ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" yaml:"shutdown_timeout" validate:"min=0s"`
-		ReadTimeout     time.Duration `env:"READ_TIMEOUT" yaml:"read_timeout" validate:"min=0s"`
-		WriteTimeout    time.Duration `env:"WRITE_TIMEOUT" yaml:"write_timeout" validate:"min=0s"`
-		IdleTimeout     time.Duration `env:"IDLE_TIMEOUT" yaml:"idle_timeout" validate:"min=0s"`
+		ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT" yaml:"shutdown_timeout" validate:"min=0s" default:"10s"`
+		ReadTimeout     time.Duration `env:"READ_TIMEOUT" yaml:"read_timeout" validate:"min=0s" default:"0s" desc:"0 disables the timeout"`
+		WriteTimeout    time.Duration `env:"WRITE_TIMEOUT" yaml:"write_timeout" validate:"min=0s" default:"0s" desc:"0 disables the timeout"`
+		IdleTimeout     time.Duration `env:"IDLE_TIMEOUT" yaml:"idle_timeout" validate:"min=0s" default:"0s" desc:"0 disables the timeout"`
+		MaxBodySize     int64         `env:"MAX_BODY_SIZE" yaml:"max_body_size" validate:"gt=0" default:"1048576" desc:"Maximum request body in bytes"`
+		DrainDelay      time.Duration `env:"DRAIN_DELAY" yaml:"drain_delay" validate:"min=0s" default:"10s" desc:"Time between failing readiness and stopping the servers on shutdown, longer than the readiness probe period of the load balancer; 0s stops at once"`
 	} `env:", prefix=SERVER_" yaml:"server" validate:"required"`
 	DB struct {
 		User     string `env:"USER, required" yaml:"user" validate:"required"`
-		Password string `env:"PASSWORD, required" yaml:"password" validate:"required"`
+		Password string `env:"PASSWORD, required" yaml:"password" validate:"required" secret:"true"`
 		Host     string `env:"HOST, required" yaml:"host" validate:"required"`
 		Port     uint   `env:"PORT, required" yaml:"port" validate:"required"`
 		Name     string `env:"NAME, required" yaml:"name" validate:"required"`
-		SSLMode  string `env:"SSLMODE" yaml:"sslmode" validate:"required"`
+		SSLMode  string `env:"SSLMODE" yaml:"sslmode" validate:"required" desc:"libpq sslmode, e.g. disable, require, verify-full"`
+
+		MaxConns               int32         `env:"MAX_CONNS" yaml:"max_conns" validate:"min=1" default:"20"`
+		MinConns               int32         `env:"MIN_CONNS" yaml:"min_conns" validate:"min=0,ltefield=MaxConns" default:"2"`
+		ConnMaxLifetime        time.Duration `env:"CONN_MAX_LIFETIME" yaml:"conn_max_lifetime" validate:"min=0s" default:"30m"`
+		ConnMaxIdleTime        time.Duration `env:"CONN_MAX_IDLE_TIME" yaml:"conn_max_idle_time" validate:"min=0s" default:"5m"`
+		StatementTimeout       time.Duration `env:"STATEMENT_TIMEOUT" yaml:"statement_timeout" validate:"min=0s" default:"5s" desc:"0 disables the timeout"`
+		StatementCacheCapacity int           `env:"STATEMENT_CACHE_CAPACITY" yaml:"statement_cache_capacity" validate:"min=0" default:"512" desc:"0 disables prepared statements, as required behind PgBouncer in transaction mode"`
+		ConnectAttempts        int           `env:"CONNECT_ATTEMPTS" yaml:"connect_attempts" validate:"min=1" default:"10"`
+		ConnectBackoff         time.Duration `env:"CONNECT_BACKOFF" yaml:"connect_backoff" validate:"min=0s" default:"500ms"`
+		ConnectBackoffMax      time.Duration `env:"CONNECT_BACKOFF_MAX" yaml:"connect_backoff_max" validate:"gtefield=ConnectBackoff" default:"10s"`
+		TxIsolation            string        `env:"TX_ISOLATION" yaml:"tx_isolation" validate:"omitempty,oneof=read_committed repeatable_read serializable" default:"read_committed"`
+		TxMaxRetries           int           `env:"TX_MAX_RETRIES" yaml:"tx_max_retries" validate:"min=0" default:"3" desc:"Retries of transactions failing with a serialization failure or deadlock"`
+		TxRetryBackoff         time.Duration `env:"TX_RETRY_BACKOFF" yaml:"tx_retry_backoff" validate:"min=0s" default:"20ms"`
+		ReplicaDSNs            []string      `env:"REPLICA_DSNS" yaml:"replica_dsns" validate:"dive,url" secret:"true" desc:"Read replica connection strings, empty to read from the primary"`
+		ReplicaCheckInterval   time.Duration `env:"REPLICA_CHECK_INTERVAL" yaml:"replica_check_interval" validate:"min=100ms" default:"5s"`
+		ReadYourWritesWindow   time.Duration `env:"READ_YOUR_WRITES_WINDOW" yaml:"read_your_writes_window" validate:"min=0s" default:"10s" desc:"How long reads of a link written by this instance go to the primary"`
 	} `env:", prefix=DB_" yaml:"db" validate:"required"`
 	ShortID struct {
-		Len      int    `env:"LEN, required" yaml:"len" validate:"min=8"`
-		Alphabet string `env:"ALPHABET, required" yaml:"alphabet" validate:"required"`
+		Len                  int           `env:"LEN, required" yaml:"len" validate:"min=4" desc:"Length of generated short IDs"`
+		MinLen               int           `env:"MIN_LEN" yaml:"min_len" validate:"omitempty,min=1,ltefield=Len" desc:"Shortest accepted short ID, defaults to len; keep it at the old len when shortening IDs"`
+		MaxLen               int           `env:"MAX_LEN" yaml:"max_len" validate:"omitempty,gtefield=Len" desc:"Longest accepted short ID, defaults to len; keep it at the old len when shortening IDs"`
+		Alphabet             string        `env:"ALPHABET, required" yaml:"alphabet" validate:"required" desc:"Characters of short IDs, each at most once; only letters, digits and - . _ ~ are allowed"`
+		ExcludeAmbiguous     bool          `env:"EXCLUDE_AMBIGUOUS" yaml:"exclude_ambiguous" desc:"Do not generate 0, O, 1, l or I; existing IDs containing them stay valid"`
+		Blocklist            []string      `env:"BLOCKLIST" yaml:"blocklist" desc:"Words of at least 2 characters generated IDs must not contain, ignoring case and digit look-alikes; reloaded without a restart"`
+		GrowAt               float64       `env:"GROW_AT" yaml:"grow_at" validate:"min=0,lt=1" default:"0.05" desc:"Collision rate or keyspace utilization above which generated IDs grow by one character, up to max_len; 0 disables growth"`
+		UsageRefreshInterval time.Duration `env:"USAGE_REFRESH_INTERVAL" yaml:"usage_refresh_interval" validate:"min=1s" default:"1m" desc:"How often links are counted to measure keyspace utilization"`
 	} `env:", prefix=SHORT_ID_" yaml:"short_id" validate:"required"`
+	LinkAccess struct {
+		Secret         string        `env:"SECRET, required" yaml:"secret" validate:"required,min=32" secret:"true" desc:"HMAC key for unlock cookies, at least 32 characters"`
+		TTL            time.Duration `env:"TTL" yaml:"ttl" validate:"min=1s" default:"1h" desc:"Lifetime of an unlock cookie"`
+		MaxAttempts    int           `env:"MAX_ATTEMPTS" yaml:"max_attempts" validate:"min=1" default:"5" desc:"Password attempts per link and client within attempts_window"`
+		AttemptsWindow time.Duration `env:"ATTEMPTS_WINDOW" yaml:"attempts_window" validate:"min=1s" default:"15m"`
+	} `env:", prefix=LINK_ACCESS_" yaml:"link_access" validate:"required"`
+	GeoIP struct {
+		DatabasePath string `env:"DATABASE_PATH" yaml:"database_path" validate:"omitempty,file" desc:"MaxMind country database, empty disables country rules"`
+	} `env:", prefix=GEOIP_" yaml:"geoip"`
+	Redirect struct {
+		QueryPassthrough bool     `env:"QUERY_PASSTHROUGH" yaml:"query_passthrough"`
+		QueryConflict    string   `env:"QUERY_CONFLICT" yaml:"query_conflict" validate:"omitempty,oneof=keep_target override append" default:"keep_target"`
+		QueryAllowlist   []string `env:"QUERY_ALLOWLIST" yaml:"query_allowlist" desc:"Query parameters passed through, * matches any suffix"`
+	} `env:", prefix=REDIRECT_" yaml:"redirect"`
+	Webhooks struct {
+		PollInterval    time.Duration `env:"POLL_INTERVAL" yaml:"poll_interval" validate:"min=100ms" default:"1s"`
+		BatchSize       int32         `env:"BATCH_SIZE" yaml:"batch_size" validate:"min=1" default:"20"`
+		MaxAttempts     int           `env:"MAX_ATTEMPTS" yaml:"max_attempts" validate:"min=1" default:"8"`
+		BackoffBase     time.Duration `env:"BACKOFF_BASE" yaml:"backoff_base" validate:"min=1s" default:"5s"`
+		BackoffMax      time.Duration `env:"BACKOFF_MAX" yaml:"backoff_max" validate:"gtefield=BackoffBase" default:"1h"`
+		RequestTimeout  time.Duration `env:"REQUEST_TIMEOUT" yaml:"request_timeout" validate:"min=1s" default:"10s"`
+		ClickThresholds []int64       `env:"CLICK_THRESHOLDS" yaml:"click_thresholds" validate:"dive,min=1"`
+		AllowedNetworks []string      `env:"ALLOWED_NETWORKS" yaml:"allowed_networks" validate:"dive,cidr" desc:"Non-public networks (CIDRs) of internal receivers webhooks may be delivered to; loopback, private and link-local addresses are refused otherwise"`
+	} `env:", prefix=WEBHOOKS_" yaml:"webhooks" validate:"required"`
+	GRPC struct {
+		Host string `env:"HOST" yaml:"host" validate:"required"`
+		Port uint   `env:"PORT" yaml:"port" validate:"required"`
+	} `env:", prefix=GRPC_" yaml:"grpc" validate:"required"`
+	Events struct {
+		Sink           string        `env:"SINK" yaml:"sink" validate:"omitempty,oneof=stdout file http" desc:"Where domain events are published: stdout, file or http; empty disables publishing"`
+		FilePath       string        `env:"FILE_PATH" yaml:"file_path" validate:"required_if=Sink file"`
+		URL            string        `env:"URL" yaml:"url" validate:"required_if=Sink http,omitempty,http_url"`
+		PollInterval   time.Duration `env:"POLL_INTERVAL" yaml:"poll_interval" validate:"min=100ms" default:"1s"`
+		BatchSize      int32         `env:"BATCH_SIZE" yaml:"batch_size" validate:"min=1" default:"100"`
+		RequestTimeout time.Duration `env:"REQUEST_TIMEOUT" yaml:"request_timeout" validate:"min=1s" default:"10s"`
+	} `env:", prefix=EVENTS_" yaml:"events" validate:"required"`
+	Health struct {
+		Timeout         time.Duration `env:"TIMEOUT" yaml:"timeout" validate:"min=100ms" default:"2s"`
+		MigrationsTable string        `env:"MIGRATIONS_TABLE" yaml:"migrations_table" validate:"required" default:"schema_migrations"`
+	} `env:", prefix=HEALTH_" yaml:"health" validate:"required"`
+	Deletion struct {
+		Retention      time.Duration `env:"RETENTION" yaml:"retention" validate:"min=0s" default:"720h" desc:"How long a deleted link can be restored before it is purged; its short ID is never reissued"`
+		PurgeInterval  time.Duration `env:"PURGE_INTERVAL" yaml:"purge_interval" validate:"min=1s" default:"1h"`
+		PurgeBatchSize int           `env:"PURGE_BATCH_SIZE" yaml:"purge_batch_size" validate:"min=1" default:"1000"`
+	} `env:", prefix=DELETION_" yaml:"deletion" validate:"required"`
+	Audit struct {
+		ActorHeader    string   `env:"ACTOR_HEADER" yaml:"actor_header" desc:"Header with the user name set by an authenticating proxy (e.g. X-Forwarded-User), recorded as the actor of changes; empty ignores it"`
+		TrustedProxies []string `env:"TRUSTED_PROXIES" yaml:"trusted_proxies" validate:"required_with=ActorHeader,dive,cidr" desc:"CIDRs of the proxies in front of the service; the client IP of their requests is taken from X-Forwarded-For, and the actor header of requests from other addresses is ignored"`
+	} `env:", prefix=AUDIT_" yaml:"audit"`
+	Workspaces struct {
+		AnonymousRole string `env:"ANONYMOUS_ROLE" yaml:"anonymous_role" validate:"omitempty,oneof=viewer editor admin" desc:"Role in the default workspace of anonymous callers and of users that are not its members; empty requires a member, a token role or an API key. admin also makes them operators"`
+	} `env:", prefix=WORKSPACES_" yaml:"workspaces"`
+	JWT struct {
+		JWKS               string        `env:"JWKS" yaml:"jwks" desc:"JWKS file path or http(s) URL of the identity provider; empty rejects bearer tokens"`
+		Issuer             string        `env:"ISSUER" yaml:"issuer" desc:"Required iss claim, empty accepts any issuer"`
+		Audience           string        `env:"AUDIENCE" yaml:"audience" desc:"Required aud claim entry, empty accepts any audience"`
+		UserClaim          string        `env:"USER_CLAIM" yaml:"user_claim" validate:"required" default:"sub" desc:"Claim with the user name, dotted for nested claims"`
+		RolesClaim         string        `env:"ROLES_CLAIM" yaml:"roles_claim" validate:"required" default:"roles" desc:"Claim with the roles, dotted for nested claims (e.g. realm_access.roles); a role applies to the default workspace, slug:role to the workspace slug"`
+		Leeway             time.Duration `env:"LEEWAY" yaml:"leeway" validate:"min=0s" default:"1m" desc:"Clock skew tolerated on exp and nbf"`
+		RefreshInterval    time.Duration `env:"REFRESH_INTERVAL" yaml:"refresh_interval" validate:"min=1s" default:"15m" desc:"How often the JWKS is reloaded"`
+		MinRefreshInterval time.Duration `env:"MIN_REFRESH_INTERVAL" yaml:"min_refresh_interval" validate:"min=0s" default:"1m" desc:"Minimum time between reloads triggered by tokens signed with an unknown key"`
+		RequestTimeout     time.Duration `env:"REQUEST_TIMEOUT" yaml:"request_timeout" validate:"min=1s" default:"10s"`
+	} `env:", prefix=JWT_" yaml:"jwt"`
+	Reload struct {
+		PollInterval time.Duration `env:"POLL_INTERVAL" yaml:"poll_interval" validate:"min=100ms" default:"5s"`
+	} `env:", prefix=RELOAD_" yaml:"reload" validate:"required"`
 }
 
 type Dependencies struct {
@@ -58,36 +178,321 @@ type Dependencies struct {
 }
 
 func main() {
-	cfg := setUpConfig()
+	paths := parseFlags()
+	if args := flag.Args(); len(args) > 0 {
+		runCommand(paths, args)
+		return
+	}
 
-	validator := setUpValidator(cfg.ShortID.Alphabet, cfg.ShortID.Len)
-	db := setUpDb(cfg)
-	linkRepoFactory := repo.NewRepoFactory(db, repo.NewLinkRepo)
+	watcher := setUpConfig(paths)
+	cfg := watcher.Config()
 
-	mux := http.NewServeMux()
-	mux.Handle("GET /ping", common_http.NewPingHandler().WithDB(db))
-	mux.Handle(
-		"POST /new",
-		links_http.NewCreateLinkHandler(links_usecase.NewCreateLinkHandler(links_usecase.CreateLinkParams{
+	shortIDs := setUpShortIDs(cfg)
+	watcher.Subscribe(func(cfg Config) {
+		if err := shortIDs.SetBlocklist(cfg.ShortID.Blocklist); err != nil {
+			log.Printf("shortIDs.SetBlocklist: %v, keeping the previous blocklist", err)
+		}
+	})
+	validator := setUpValidator(shortIDs)
+	pool, db := setUpDb(cfg)
+	replicas := setUpReplicas(cfg)
+	txPolicy := setUpTxPolicy(cfg)
+	linkRepoFactory := repo.NewRepoFactory(pool, repo.NewLinkRepo).WithTxPolicy(txPolicy).WithReplicas(replicas)
+	webhookRepoFactory := repo.NewRepoFactory(pool, repo.NewWebhookRepo).WithTxPolicy(txPolicy).WithReplicas(replicas)
+	webhookAddresses := setUpWebhookAddresses(cfg)
+	workspaceRepoFactory := repo.NewRepoFactory(pool, repo.NewWorkspaceRepo).WithTxPolicy(txPolicy).WithReplicas(replicas)
+	recentWrites := usecase.NewRecentWrites(cfg.DB.ReadYourWritesWindow)
+	accessSigner := signature.NewSigner([]byte(cfg.LinkAccess.Secret))
+	countryResolver := setUpCountryResolver(cfg)
+	unlockThrottler := throttle.NewLimiter(cfg.LinkAccess.MaxAttempts, cfg.LinkAccess.AttemptsWindow)
+	watcher.Subscribe(func(cfg Config) {
+		unlockThrottler.SetLimit(cfg.LinkAccess.MaxAttempts, cfg.LinkAccess.AttemptsWindow)
+	})
+
+	useCases := UseCases{
+		CreateLink: links_usecase.NewCreateLinkHandler(links_usecase.CreateLinkParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			ShortIDs:     shortIDs,
+			RecentWrites: recentWrites,
+		}),
+		ImportLinks: links_usecase.NewImportLinksHandler(links_usecase.ImportLinksParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			ShortIDs:     shortIDs,
+			RecentWrites: recentWrites,
+		}),
+		GetLink: links_usecase.NewGetLinkByShortIDHandler(links_usecase.GetLinkByShortIDParams{
+			RepoFactory:     linkRepoFactory,
+			Validator:       validator,
+			AccessSigner:    accessSigner,
+			CountryResolver: countryResolver,
+			QueryPolicy: links_usecase.QueryPolicy{
+				Passthrough: cfg.Redirect.QueryPassthrough,
+				Conflict:    cfg.Redirect.QueryConflict,
+				Allowlist:   cfg.Redirect.QueryAllowlist,
+			},
+			ClickThresholds: cfg.Webhooks.ClickThresholds,
+			RecentWrites:    recentWrites,
+		}),
+		GetLinkStats: links_usecase.NewGetLinkStatsHandler(links_usecase.GetLinkStatsParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			RecentWrites: recentWrites,
+		}),
+		UnlockLink: links_usecase.NewUnlockLinkHandler(links_usecase.UnlockLinkParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			AccessSigner: accessSigner,
+			Throttler:    unlockThrottler,
+			AccessTTL:    cfg.LinkAccess.TTL,
+		}),
+		RestoreLink: links_usecase.NewRestoreLinkHandler(links_usecase.RestoreLinkParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			RecentWrites: recentWrites,
+			Retention:    cfg.Deletion.Retention,
+		}),
+		GetShortIDStats: links_usecase.NewGetShortIDStatsHandler(links_usecase.GetShortIDStatsParams{
+			Capacity: shortIDs,
+		}),
+		UpdateLink: links_usecase.NewUpdateLinkHandler(links_usecase.UpdateLinkParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			RecentWrites: recentWrites,
+		}),
+		DeleteLink: links_usecase.NewDeleteLinkHandler(links_usecase.DeleteLinkParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			RecentWrites: recentWrites,
+		}),
+		GetLinkHistory: links_usecase.NewGetLinkHistoryHandler(links_usecase.GetLinkHistoryParams{
+			RepoFactory:  linkRepoFactory,
+			Validator:    validator,
+			RecentWrites: recentWrites,
+		}),
+		ListLinks: links_usecase.NewListLinksHandler(links_usecase.ListLinksParams{
 			RepoFactory: linkRepoFactory,
 			Validator:   validator,
-			ShortIDLen:  cfg.ShortID.Len,
-			Alphabet:    []rune(cfg.ShortID.Alphabet),
-		})),
-	)
-	mux.Handle(
-		"GET /s/{short_id}",
-		links_http.NewRedirectHandler(links_usecase.NewGetLinkByShortIDHandler(links_usecase.GetLinkByShortIDParams{
+		}),
+		ListTags: links_usecase.NewListTagsHandler(links_usecase.ListTagsParams{
+			RepoFactory: linkRepoFactory,
+		}),
+		ListAuditEntries: links_usecase.NewListAuditEntriesHandler(links_usecase.ListAuditEntriesParams{
 			RepoFactory: linkRepoFactory,
 			Validator:   validator,
-		})),
-	)
+		}),
+		RegisterWebhook: webhooks_usecase.NewRegisterWebhookHandler(webhooks_usecase.RegisterWebhookParams{
+			RepoFactory:   webhookRepoFactory,
+			Validator:     validator,
+			AddressPolicy: webhookAddresses,
+		}),
+		ListWebhooks: webhooks_usecase.NewListWebhooksHandler(webhooks_usecase.ListWebhooksParams{
+			RepoFactory: webhookRepoFactory,
+		}),
+		DeleteWebhook: webhooks_usecase.NewDeleteWebhookHandler(webhooks_usecase.DeleteWebhookParams{
+			RepoFactory: webhookRepoFactory,
+			Validator:   validator,
+		}),
+		ListWebhookDeliveries: webhooks_usecase.NewListWebhookDeliveriesHandler(webhooks_usecase.ListWebhookDeliveriesParams{
+			RepoFactory: webhookRepoFactory,
+			Validator:   validator,
+		}),
+		ReplayWebhookDeliveries: webhooks_usecase.NewReplayWebhookDeliveriesHandler(webhooks_usecase.ReplayWebhookDeliveriesParams{
+			RepoFactory: webhookRepoFactory,
+			Validator:   validator,
+		}),
+		CreateWorkspace: workspaces_usecase.NewCreateWorkspaceHandler(workspaces_usecase.CreateWorkspaceParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		ListWorkspaces: workspaces_usecase.NewListWorkspacesHandler(workspaces_usecase.ListWorkspacesParams{
+			RepoFactory: workspaceRepoFactory,
+		}),
+		UpdateWorkspace: workspaces_usecase.NewUpdateWorkspaceHandler(workspaces_usecase.UpdateWorkspaceParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		GetWorkspace: workspaces_usecase.NewGetWorkspaceHandler(workspaces_usecase.GetWorkspaceParams{
+			RepoFactory: workspaceRepoFactory,
+		}),
+		SetMember: workspaces_usecase.NewSetMemberHandler(workspaces_usecase.SetMemberParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		RemoveMember: workspaces_usecase.NewRemoveMemberHandler(workspaces_usecase.RemoveMemberParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		ListMembers: workspaces_usecase.NewListMembersHandler(workspaces_usecase.ListMembersParams{
+			RepoFactory: workspaceRepoFactory,
+		}),
+		CreateAPIKey: workspaces_usecase.NewCreateAPIKeyHandler(workspaces_usecase.CreateAPIKeyParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		ListAPIKeys: workspaces_usecase.NewListAPIKeysHandler(workspaces_usecase.ListAPIKeysParams{
+			RepoFactory: workspaceRepoFactory,
+		}),
+		RevokeAPIKey: workspaces_usecase.NewRevokeAPIKeyHandler(workspaces_usecase.RevokeAPIKeyParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		AddDomain: workspaces_usecase.NewAddDomainHandler(workspaces_usecase.AddDomainParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+		RemoveDomain: workspaces_usecase.NewRemoveDomainHandler(workspaces_usecase.RemoveDomainParams{
+			RepoFactory: workspaceRepoFactory,
+			Validator:   validator,
+		}),
+	}
+	keySet, tokenVerifier := setUpTokenVerifier(cfg)
+	authenticator := workspaces_usecase.NewAuthenticateHandler(workspaces_usecase.AuthenticateParams{
+		RepoFactory:   workspaceRepoFactory,
+		Tokens:        tokenVerifier,
+		AnonymousRole: cfg.Workspaces.AnonymousRole,
+	})
+
+	mux := http.NewServeMux()
+	publisher, closePublisher := setUpPublisher(cfg)
+	readiness := setUpReadiness(cfg, db, publisher)
+	setUpRoutes(mux, db, readiness, useCases)
+	trustedProxies := setUpTrustedProxies(cfg)
+	handler := httpx.WithInstance(httpx.ForwardedFor(trustedProxies, httpx.Authenticate(authenticator, cfg.Audit.ActorHeader, trustedProxies, httpx.LimitBody(cfg.Server.MaxBodySize, setUpRequestValidation(mux)))))
+
+	grpcServer, grpcHealth := setUpGrpcServer(cfg, authenticator, trustedProxies, links_grpc.NewServer(links_grpc.ServerParams{
+		CreateLink:   useCases.CreateLink,
+		GetLink:      useCases.GetLink,
+		GetLinkStats: useCases.GetLinkStats,
+		UpdateLink:   useCases.UpdateLink,
+		DeleteLink:   useCases.DeleteLink,
+	}))
 
-	shutdownFn := startServer(cfg, mux)
+	shortIDUsage := links_usecase.NewRefreshShortIDUsageHandler(links_usecase.RefreshShortIDUsageParams{
+		RepoFactory: linkRepoFactory,
+		Capacity:    shortIDs,
+	})
+	purgeDeletedLinks := links_usecase.NewPurgeDeletedLinksHandler(links_usecase.PurgeDeletedLinksParams{
+		RepoFactory: linkRepoFactory,
+		Retention:   cfg.Deletion.Retention,
+		BatchSize:   cfg.Deletion.PurgeBatchSize,
+	})
+	stopWorkers := startWorkers(cfg, webhookRepoFactory, webhookAddresses, repo.NewRepoFactory(pool, repo.NewOutboxRepo).WithTxPolicy(txPolicy), publisher, replicas, keySet, shortIDUsage, purgeDeletedLinks)
+	shutdownFn := startServer(cfg, handler, grpcServer, grpcHealth)
+
+	watchCtx, stopWatch := context.WithCancel(context.Background())
+	go watcher.Watch(watchCtx, cfg.Reload.PollInterval)
 
 	waitStop()
+	stopWatch()
+
+	readiness.Drain()
+	grpcHealth.Shutdown()
+	log.Printf("Draining for %s\n", cfg.Server.DrainDelay)
+	time.Sleep(cfg.Server.DrainDelay)
 
 	shutdownFn()
+	stopWorkers()
+	closePublisher()
+	db.Close()
+	pool.Close()
+	if replicas != nil {
+		replicas.Close()
+	}
+}
+
+type UseCases struct {
+	CreateLink              links_usecase.ICreateLinkHandler
+	ImportLinks             links_usecase.IImportLinksHandler
+	GetLink                 links_usecase.IGetLinkByShortIDHandler
+	GetLinkStats            links_usecase.IGetLinkStatsHandler
+	UnlockLink              links_usecase.IUnlockLinkHandler
+	RestoreLink             links_usecase.IRestoreLinkHandler
+	GetShortIDStats         links_usecase.IGetShortIDStatsHandler
+	UpdateLink              links_usecase.IUpdateLinkHandler
+	DeleteLink              links_usecase.IDeleteLinkHandler
+	GetLinkHistory          links_usecase.IGetLinkHistoryHandler
+	ListLinks               links_usecase.IListLinksHandler
+	ListTags                links_usecase.IListTagsHandler
+	ListAuditEntries        links_usecase.IListAuditEntriesHandler
+	RegisterWebhook         webhooks_usecase.IRegisterWebhookHandler
+	ListWebhooks            webhooks_usecase.IListWebhooksHandler
+	DeleteWebhook           webhooks_usecase.IDeleteWebhookHandler
+	ListWebhookDeliveries   webhooks_usecase.IListWebhookDeliveriesHandler
+	ReplayWebhookDeliveries webhooks_usecase.IReplayWebhookDeliveriesHandler
+	CreateWorkspace         workspaces_usecase.ICreateWorkspaceHandler
+	ListWorkspaces          workspaces_usecase.IListWorkspacesHandler
+	UpdateWorkspace         workspaces_usecase.IUpdateWorkspaceHandler
+	GetWorkspace            workspaces_usecase.IGetWorkspaceHandler
+	SetMember               workspaces_usecase.ISetMemberHandler
+	RemoveMember            workspaces_usecase.IRemoveMemberHandler
+	ListMembers             workspaces_usecase.IListMembersHandler
+	CreateAPIKey            workspaces_usecase.ICreateAPIKeyHandler
+	ListAPIKeys             workspaces_usecase.IListAPIKeysHandler
+	RevokeAPIKey            workspaces_usecase.IRevokeAPIKeyHandler
+	AddDomain               workspaces_usecase.IAddDomainHandler
+	RemoveDomain            workspaces_usecase.IRemoveDomainHandler
+}
+
+type Router interface {
+	Handle(pattern string, handler http.Handler)
+}
+
+func setUpRoutes(router Router, db *sql.DB, readiness *health.Readiness, useCases UseCases) {
+	router.Handle("GET /ping", common_http.NewPingHandler().WithDB(db))
+	router.Handle("GET /healthz", common_http.NewLivenessHandler())
+	router.Handle("GET /readyz", common_http.NewReadinessHandler(readiness))
+	router.Handle("GET /openapi.json", openapi.NewSpecHandler())
+	router.Handle("GET /docs", openapi.NewDocsHandler())
+	router.Handle("GET /docs/{file}", openapi.NewDocsAssetHandler())
+	router.Handle("GET /ui", ui.NewPageHandler())
+	router.Handle("GET /ui/{file}", ui.NewAssetHandler())
+
+	router.Handle("POST /new", links_http.NewCreateLinkHandler(useCases.CreateLink))
+	redirectHandler := links_http.NewRedirectHandler(useCases.GetLink)
+	router.Handle("GET /s/{short_id}", redirectHandler)
+	router.Handle("GET /s/{short_id}/{path...}", redirectHandler)
+	unlockHandler := links_http.NewUnlockLinkHandler(useCases.UnlockLink)
+	router.Handle("POST /s/{short_id}", unlockHandler)
+	router.Handle("POST /s/{short_id}/{path...}", unlockHandler)
+	router.Handle("GET /links", links_http.NewListLinksHandler(useCases.ListLinks))
+	router.Handle("GET /tags", links_http.NewListTagsHandler(useCases.ListTags))
+	router.Handle("GET /links/{short_id}/stats", links_http.NewGetLinkStatsHandler(useCases.GetLinkStats))
+	router.Handle("POST /links/import", links_http.NewImportLinksHandler(useCases.ImportLinks))
+	router.Handle("PATCH /links/{short_id}", links_http.NewUpdateLinkHandler(useCases.UpdateLink))
+	router.Handle("DELETE /links/{short_id}", links_http.NewDeleteLinkHandler(useCases.DeleteLink))
+	router.Handle("POST /links/{short_id}/restore", links_http.NewRestoreLinkHandler(useCases.RestoreLink))
+	router.Handle("GET /links/{short_id}/history", links_http.NewGetLinkHistoryHandler(useCases.GetLinkHistory))
+	router.Handle("GET /audit", links_http.NewListAuditEntriesHandler(useCases.ListAuditEntries))
+	router.Handle("GET /admin/short-ids", links_http.NewGetShortIDStatsHandler(useCases.GetShortIDStats))
+
+	router.Handle("POST /webhooks", webhooks_http.NewRegisterWebhookHandler(useCases.RegisterWebhook))
+	router.Handle("GET /webhooks", webhooks_http.NewListWebhooksHandler(useCases.ListWebhooks))
+	router.Handle("DELETE /webhooks/{id}", webhooks_http.NewDeleteWebhookHandler(useCases.DeleteWebhook))
+	router.Handle("GET /webhooks/{id}/deliveries", webhooks_http.NewListWebhookDeliveriesHandler(useCases.ListWebhookDeliveries))
+	router.Handle("POST /webhooks/{id}/replay", webhooks_http.NewReplayWebhookDeliveriesHandler(useCases.ReplayWebhookDeliveries))
+
+	router.Handle("POST /workspaces", workspaces_http.NewCreateWorkspaceHandler(useCases.CreateWorkspace))
+	router.Handle("GET /workspaces", workspaces_http.NewListWorkspacesHandler(useCases.ListWorkspaces))
+	router.Handle("PATCH /workspaces/{slug}", workspaces_http.NewUpdateWorkspaceHandler(useCases.UpdateWorkspace))
+	router.Handle("GET /workspace", workspaces_http.NewGetWorkspaceHandler(useCases.GetWorkspace))
+	router.Handle("GET /workspace/members", workspaces_http.NewListMembersHandler(useCases.ListMembers))
+	router.Handle("PUT /workspace/members/{user}", workspaces_http.NewSetMemberHandler(useCases.SetMember))
+	router.Handle("DELETE /workspace/members/{user}", workspaces_http.NewRemoveMemberHandler(useCases.RemoveMember))
+	router.Handle("POST /workspace/api-keys", workspaces_http.NewCreateAPIKeyHandler(useCases.CreateAPIKey))
+	router.Handle("GET /workspace/api-keys", workspaces_http.NewListAPIKeysHandler(useCases.ListAPIKeys))
+	router.Handle("DELETE /workspace/api-keys/{id}", workspaces_http.NewRevokeAPIKeyHandler(useCases.RevokeAPIKey))
+	router.Handle("PUT /workspace/domains/{domain}", workspaces_http.NewAddDomainHandler(useCases.AddDomain))
+	router.Handle("DELETE /workspace/domains/{domain}", workspaces_http.NewRemoveDomainHandler(useCases.RemoveDomain))
+}
+
+func setUpRequestValidation(mux *http.ServeMux) http.Handler {
+	doc, err := openapi.Load()
+	if err != nil {
+		log.Fatalf("openapi.Load: %v", err)
+	}
+	return openapi.ValidateRequests(doc, mux)
 }
 
 func waitStop() {
@@ -96,10 +501,10 @@ func waitStop() {
 	<-ch
 }
 
-func startServer(cfg Config, mux *http.ServeMux) func() {
+func startServer(cfg Config, handler http.Handler, grpcServer *grpc.Server, grpcHealth *grpc_health.Server) func() {
 	server := &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
-		Handler:      mux,
+		Handler:      handler,
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 		IdleTimeout:  cfg.Server.IdleTimeout,
@@ -113,18 +518,169 @@ func startServer(cfg Config, mux *http.ServeMux) func() {
 		log.Println("Server stops serving new connections")
 	}()
 
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port)
+	listener, err := net.Listen("tcp", grpcAddr)
+	if err != nil {
+		log.Fatalf("net.Listen: %v", err)
+	}
+	go func() {
+		log.Printf("gRPC server is starting %s\n", grpcAddr)
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Fatalf("gRPC server error: %v", err)
+		}
+		log.Println("gRPC server stops serving new connections")
+	}()
+
 	return func() {
 		ctx, release := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 		defer release()
 
+		grpcStopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(grpcStopped)
+		}()
+
 		if err := server.Shutdown(ctx); err != nil {
 			log.Fatalf("HTTP shutdown error: %v", err)
 		}
+
+		select {
+		case <-grpcStopped:
+		case <-ctx.Done():
+			grpcServer.Stop()
+		}
 		log.Println("Graceful shutdown complete.")
 	}
 }
 
-func setUpDb(cfg Config) *sql.DB {
+func setUpGrpcServer(cfg Config, authenticator usecase.Authenticator, trustedProxies []netip.Prefix, linkServer linksv1.LinkServiceServer) (*grpc.Server, *grpc_health.Server) {
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcx.RecoverInterceptor(),
+		grpcx.AuthInterceptor(authenticator, cfg.Audit.ActorHeader, trustedProxies),
+	))
+	linksv1.RegisterLinkServiceServer(grpcServer, linkServer)
+
+	healthServer := grpc_health.NewServer()
+	healthServer.SetServingStatus(linksv1.LinkService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	reflection.Register(grpcServer)
+	return grpcServer, healthServer
+}
+
+func startWorkers(
+	cfg Config,
+	webhookRepoFactory usecase.RepoFactory[webhooks_usecase.WebhookRepo],
+	webhookAddresses *webhook.AddressPolicy,
+	outboxRepoFactory usecase.RepoFactory[events.Outbox],
+	publisher events.Publisher,
+	replicas *repo.Replicas,
+	keySet *jwt.KeySet,
+	shortIDUsage links_usecase.IRefreshShortIDUsageHandler,
+	purgeDeletedLinks links_usecase.IPurgeDeletedLinksHandler,
+) func() {
+	dispatcher := webhooks_usecase.NewDispatchWebhooksHandler(webhooks_usecase.DispatchWebhooksParams{
+		RepoFactory: webhookRepoFactory,
+		Sender:      webhook.NewClient(cfg.Webhooks.RequestTimeout, webhookAddresses),
+		BatchSize:   cfg.Webhooks.BatchSize,
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		BackoffBase: cfg.Webhooks.BackoffBase,
+		BackoffMax:  cfg.Webhooks.BackoffMax,
+		Lease:       cfg.Webhooks.RequestTimeout * time.Duration(cfg.Webhooks.BatchSize),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		worker.Run(ctx, "webhooks dispatcher", cfg.Webhooks.PollInterval, dispatcher.Handle)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		worker.Run(ctx, "short ID usage", cfg.ShortID.UsageRefreshInterval, shortIDUsage.Handle)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		worker.Run(ctx, "deleted links purge", cfg.Deletion.PurgeInterval, purgeDeletedLinks.Handle)
+	}()
+
+	if replicas != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker.Run(ctx, "replica health", cfg.DB.ReplicaCheckInterval, replicas.Check)
+		}()
+	}
+
+	if keySet != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker.Run(ctx, "jwks refresh", cfg.JWT.RefreshInterval, keySet.Refresh)
+		}()
+	}
+
+	if publisher != nil {
+		relay := events.NewRelay(events.RelayParams{
+			RepoFactory: outboxRepoFactory,
+			Publisher:   publisher,
+			BatchSize:   cfg.Events.BatchSize,
+		})
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker.Run(ctx, "events relay", cfg.Events.PollInterval, relay.Handle)
+		}()
+	}
+
+	return func() {
+		cancel()
+		wg.Wait()
+		log.Println("Workers stopped.")
+	}
+}
+
+func setUpPublisher(cfg Config) (events.Publisher, func()) {
+	switch cfg.Events.Sink {
+	case "stdout":
+		return events.NewWriterPublisher(os.Stdout), func() {}
+	case "file":
+		f, err := os.OpenFile(cfg.Events.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			log.Fatalf("os.OpenFile: %v", err)
+		}
+		return events.NewWriterPublisher(f), func() { f.Close() }
+	case "http":
+		return events.NewHTTPPublisher(cfg.Events.URL, cfg.Events.RequestTimeout), func() {}
+	default:
+		return nil, func() {}
+	}
+}
+
+func setUpReadiness(cfg Config, db *sql.DB, publisher events.Publisher) *health.Readiness {
+	latest, err := migrations.Latest()
+	if err != nil {
+		log.Fatalf("migrations.Latest: %v", err)
+	}
+
+	readiness := health.NewReadiness(cfg.Health.Timeout).
+		Add("db", health.PingDB(db)).
+		Add("migrations", health.MigrationVersion(db, cfg.Health.MigrationsTable, latest))
+	if checker, ok := publisher.(interface{ Check(context.Context) error }); ok {
+		readiness.Add("events_sink", checker.Check)
+	}
+	return readiness
+}
+
+// setUpDb connects the pgx pool used by the repositories and a database/sql
+// handle over the same pool for health checks.
+func setUpDb(cfg Config) (*pgxpool.Pool, *sql.DB) {
 	v := make(url.Values, 1)
 	v.Set("sslmode", cfg.DB.SSLMode)
 	connString := url.URL{
@@ -134,43 +690,244 @@ func setUpDb(cfg Config) *sql.DB {
 		Path:     cfg.DB.Name,
 		RawQuery: v.Encode(),
 	}
-	db, err := sql.Open("pgx", connString.String())
+	pool := newPool(cfg, connString.String())
+	err := repo.WaitForDB(context.Background(), pool.Ping, repo.ConnectPolicy{
+		Attempts:   cfg.DB.ConnectAttempts,
+		Backoff:    cfg.DB.ConnectBackoff,
+		BackoffMax: cfg.DB.ConnectBackoffMax,
+	})
+	if err != nil {
+		log.Fatalf("repo.WaitForDB: %v", err)
+	}
+	return pool, stdlib.OpenDBFromPool(pool)
+}
+
+// setUpReplicas connects the read-only pools; their health is checked by a
+// worker, see startWorkers.
+func setUpReplicas(cfg Config) *repo.Replicas {
+	if len(cfg.DB.ReplicaDSNs) == 0 {
+		return nil
+	}
+	pools := make([]*pgxpool.Pool, 0, len(cfg.DB.ReplicaDSNs))
+	for _, dsn := range cfg.DB.ReplicaDSNs {
+		pools = append(pools, newPool(cfg, dsn))
+	}
+	return repo.NewReplicas(pools)
+}
+
+func newPool(cfg Config, connString string) *pgxpool.Pool {
+	poolCfg, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		log.Fatalf("pgxpool.ParseConfig: %v", err)
+	}
+	poolCfg.MaxConns = cfg.DB.MaxConns
+	poolCfg.MinConns = cfg.DB.MinConns
+	poolCfg.MaxConnLifetime = cfg.DB.ConnMaxLifetime
+	poolCfg.MaxConnIdleTime = cfg.DB.ConnMaxIdleTime
+	poolCfg.ConnConfig.StatementCacheCapacity = cfg.DB.StatementCacheCapacity
+	if cfg.DB.StatementCacheCapacity == 0 {
+		poolCfg.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeExec
+	}
+	if cfg.DB.StatementTimeout > 0 {
+		poolCfg.ConnConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(cfg.DB.StatementTimeout.Milliseconds(), 10)
+	}
+
+	pool, err := pgxpool.NewWithConfig(context.Background(), poolCfg)
+	if err != nil {
+		log.Fatalf("pgxpool.NewWithConfig: %v", err)
+	}
+	return pool
+}
+
+func setUpTxPolicy(cfg Config) repo.TxPolicy {
+	isolation, err := repo.ParseIsolationLevel(cfg.DB.TxIsolation)
 	if err != nil {
-		log.Fatal(err)
+		log.Fatalf("repo.ParseIsolationLevel: %v", err)
+	}
+	return repo.TxPolicy{
+		Isolation:    isolation,
+		MaxRetries:   cfg.DB.TxMaxRetries,
+		RetryBackoff: cfg.DB.TxRetryBackoff,
+	}
+}
+
+func setUpCountryResolver(cfg Config) links_usecase.CountryResolver {
+	if cfg.GeoIP.DatabasePath == "" {
+		return geoip.NoopResolver{}
+	}
+	resolver, err := geoip.OpenMaxMind(cfg.GeoIP.DatabasePath)
+	if err != nil {
+		log.Fatalf("geoip.OpenMaxMind: %v", err)
+	}
+	return resolver
+}
+
+// setUpTokenVerifier returns nil when bearer tokens are disabled. The keys
+// are loaded by a worker, see startWorkers, or by the first token.
+func setUpTokenVerifier(cfg Config) (*jwt.KeySet, usecase.TokenVerifier) {
+	if cfg.JWT.JWKS == "" {
+		return nil, nil
+	}
+	keySet := jwt.NewKeySet(jwt.KeySetParams{
+		Source:             cfg.JWT.JWKS,
+		RequestTimeout:     cfg.JWT.RequestTimeout,
+		MinRefreshInterval: cfg.JWT.MinRefreshInterval,
+	})
+	return keySet, jwt.NewVerifier(jwt.VerifierParams{
+		Keys:       keySet,
+		Issuer:     cfg.JWT.Issuer,
+		Audience:   cfg.JWT.Audience,
+		Leeway:     cfg.JWT.Leeway,
+		UserClaim:  cfg.JWT.UserClaim,
+		RolesClaim: cfg.JWT.RolesClaim,
+	})
+}
+
+func setUpTrustedProxies(cfg Config) []netip.Prefix {
+	prefixes := make([]netip.Prefix, 0, len(cfg.Audit.TrustedProxies))
+	for _, cidr := range cfg.Audit.TrustedProxies {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			log.Fatalf("netip.ParsePrefix: %v", err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
 	}
-	err = db.Ping()
+	return prefixes
+}
+
+func setUpWebhookAddresses(cfg Config) *webhook.AddressPolicy {
+	allowed := make([]netip.Prefix, 0, len(cfg.Webhooks.AllowedNetworks))
+	for _, cidr := range cfg.Webhooks.AllowedNetworks {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			log.Fatalf("netip.ParsePrefix: %v", err)
+		}
+		allowed = append(allowed, prefix.Masked())
+	}
+	return webhook.NewAddressPolicy(allowed)
+}
+
+func setUpShortIDs(cfg Config) *shortid.Policy {
+	policy, err := shortid.NewPolicy(shortid.PolicyParams{
+		Alphabet:         cfg.ShortID.Alphabet,
+		Len:              cfg.ShortID.Len,
+		MinLen:           cfg.ShortID.MinLen,
+		MaxLen:           cfg.ShortID.MaxLen,
+		ExcludeAmbiguous: cfg.ShortID.ExcludeAmbiguous,
+		Blocklist:        cfg.ShortID.Blocklist,
+		GrowAt:           cfg.ShortID.GrowAt,
+	})
 	if err != nil {
-		log.Fatalf("db.Ping: %v", err)
+		log.Fatalf("shortid.NewPolicy: %v", err)
 	}
-	return db
+	return policy
 }
 
-func setUpValidator(alphabet string, len int) *validator10.Validate {
+func setUpValidator(shortIDs *shortid.Policy) *validator10.Validate {
 	validator := validator10.New(validator10.WithRequiredStructEnabled())
-	pattern := regexp.MustCompile(fmt.Sprintf(`^[%s]{%d}$`, alphabet, len))
 	validator.RegisterValidation("short_id", func(fl validator10.FieldLevel) bool {
-		return pattern.MatchString(fl.Field().String())
+		return shortIDs.Valid(fl.Field().String())
 	})
 	return validator
 }
 
-func setUpConfig() Config {
+// configPaths collects repeated -config flags, later files overriding earlier ones.
+type configPaths []string
+
+func (p *configPaths) String() string {
+	return strings.Join(*p, ",")
+}
+
+func (p *configPaths) Set(value string) error {
+	*p = append(*p, value)
+	return nil
+}
+
+func parseFlags() []string {
 	workDir, err := os.Getwd()
 	if err != nil {
 		log.Fatalf("os.Getwd: %v", err)
 	}
 	log.Printf("Working directory: %s\n", workDir)
 
-	var configPath string
-	defaultConfigPath := filepath.Join(workDir, "config", "local.yaml")
-	flag.StringVar(&configPath, "config", defaultConfigPath, "config path")
-	flag.StringVar(&configPath, "c", defaultConfigPath, "config path")
+	var paths configPaths
+	flag.Var(&paths, "config", "config path, repeat to layer overlays (default config/local.yaml)")
+	flag.Var(&paths, "c", "config path, repeat to layer overlays (default config/local.yaml)")
 	flag.Parse()
+	if len(paths) == 0 {
+		paths = configPaths{filepath.Join(workDir, "config", "local.yaml")}
+	}
+	return paths
+}
 
-	cfg, err := config.GetConfig[Config](configPath)
+func setUpConfig(paths []string) *config.Watcher[Config] {
+	watcher, err := config.NewWatcher[Config](paths...)
 	if err != nil {
-		log.Fatalf("config.GetConfig: %v", err)
+		log.Fatalf("config.NewWatcher: %v", err)
 	}
-	log.Printf("Configuration file: %s\n", configPath)
-	return cfg
+	log.Printf("Configuration files: %s\n", strings.Join(paths, ","))
+	return watcher
 }
+
+// runCommand runs a command given after the flags instead of the server:
+//
+//	config print             the effective configuration and where each value came from
+//	config docs              the configuration reference as Markdown
+//	config env               the configuration reference as an env file
+//	api-key create <name>    a new admin key of the default workspace
+func runCommand(paths []string, args []string) {
+	if len(args) == 3 && args[0] == "api-key" && args[1] == "create" {
+		createAdminAPIKey(paths, args[2])
+		return
+	}
+
+	var err error
+	switch strings.Join(args, " ") {
+	case "config print":
+		cfg, sources, loadErr := config.Load[Config](paths...)
+		if loadErr != nil {
+			log.Fatalf("config.Load: %v", loadErr)
+		}
+		err = config.Print(os.Stdout, cfg, sources)
+	case "config docs":
+		err = config.WriteMarkdown[Config](os.Stdout, "Configuration", configDocsCommand)
+	case "config env":
+		err = config.WriteEnv[Config](os.Stdout, configEnvCommand)
+	default:
+		log.Fatalf("unknown command %q, expected config print, config docs, config env or api-key create <name>", strings.Join(args, " "))
+	}
+	if err != nil {
+		log.Fatalf("%s: %v", strings.Join(args, " "), err)
+	}
+}
+
+// createAdminAPIKey issues an admin key of the default workspace and prints
+// it, so that a new installation can be managed without an anonymous role.
+func createAdminAPIKey(paths []string, name string) {
+	cfg, _, err := config.Load[Config](paths...)
+	if err != nil {
+		log.Fatalf("config.Load: %v", err)
+	}
+	pool, _ := setUpDb(cfg)
+	defer pool.Close()
+
+	handler := workspaces_usecase.NewCreateAPIKeyHandler(workspaces_usecase.CreateAPIKeyParams{
+		RepoFactory: repo.NewRepoFactory(pool, repo.NewWorkspaceRepo),
+		Validator:   validator10.New(validator10.WithRequiredStructEnabled()),
+	})
+	ctx := usecase.WithPrincipal(context.Background(), usecase.Principal{
+		Actor:       usecase.Actor{Type: usecase.ActorSystem, ID: "cli"},
+		WorkspaceID: usecase.DefaultWorkspaceID,
+		Role:        usecase.RoleAdmin,
+	})
+	result, err := handler.Handle(ctx, workspaces_usecase.CreateAPIKeyData{Name: name, Role: usecase.RoleAdmin})
+	if err != nil {
+		log.Fatalf("createAPIKey.Handle: %v", err)
+	}
+	fmt.Println(result.Key)
+}
+
+const (
+	configDocsCommand = "go run ./cmd/main.go config docs > docs/configuration.md"
+	configEnvCommand  = "go run ./cmd/main.go config env > envs/example.env"
+)
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/openapi"
+	"github.com/kirillismad/go-url-shortener/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+type routeRecorder []string
+
+func (r *routeRecorder) Handle(pattern string, handler http.Handler) {
+	method, path, _ := strings.Cut(pattern, " ")
+	*r = append(*r, method+" "+openapi.SpecPath(path))
+}
+
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	doc, err := openapi.Load()
+	require.NoError(t, err)
+
+	var documented []string
+	for path, operations := range doc.Paths {
+		for method := range operations {
+			documented = append(documented, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	var registered routeRecorder
+	setUpRoutes(&registered, nil, nil, UseCases{})
+
+	sort.Strings(documented)
+	sort.Strings(registered)
+	require.Equal(t, documented, []string(registered))
+}
+
+func TestConfigReferenceUpToDate(t *testing.T) {
+	var docs, env bytes.Buffer
+	require.NoError(t, config.WriteMarkdown[Config](&docs, "Configuration", configDocsCommand))
+	require.NoError(t, config.WriteEnv[Config](&env, configEnvCommand))
+
+	committedDocs, err := os.ReadFile("../docs/configuration.md")
+	require.NoError(t, err)
+	committedEnv, err := os.ReadFile("../envs/example.env")
+	require.NoError(t, err)
+
+	require.Equal(t, string(committedDocs), docs.String(), "run: "+configDocsCommand)
+	require.Equal(t, string(committedEnv), env.String(), "run: "+configEnvCommand)
+}
@@ -0,0 +1,37 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/health"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type LivenessHandler struct{}
+
+func NewLivenessHandler() *LivenessHandler {
+	return new(LivenessHandler)
+}
+
+func (h *LivenessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJson(r.Context(), w, http.StatusOK, health.Report{Status: health.StatusUp, Checks: []health.CheckResult{}})
+}
+
+type ReadinessHandler struct {
+	readiness *health.Readiness
+}
+
+func NewReadinessHandler(readiness *health.Readiness) *ReadinessHandler {
+	return &ReadinessHandler{readiness: readiness}
+}
+
+func (h *ReadinessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	report := h.readiness.Run(ctx)
+	status := http.StatusOK
+	if report.Status != health.StatusUp {
+		status = http.StatusServiceUnavailable
+	}
+	httpx.WriteJson(ctx, w, status, report)
+}
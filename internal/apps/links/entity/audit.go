@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+	AuditActionPurge   = "purge"
+)
+
+// AuditEntry records a change of a link. OldValue and NewValue are JSON
+// snapshots of the link, nil where the link did not exist.
+type AuditEntry struct {
+	ID        int64
+	LinkID    int64
+	ShortID   string
+	Action    string
+	ActorType string
+	ActorID   string
+	OldValue  []byte
+	NewValue  []byte
+	CreatedAt time.Time
+}
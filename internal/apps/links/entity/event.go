@@ -0,0 +1,10 @@
+package entity
+
+const (
+	EventLinkCreated               = "link.created"
+	EventLinkUpdated               = "link.updated"
+	EventLinkDeleted               = "link.deleted"
+	EventLinkRestored              = "link.restored"
+	EventLinkExpired               = "link.expired"
+	EventLinkClickThresholdReached = "link.click_threshold_reached"
+)
@@ -3,10 +3,50 @@ package entity
 import "time"
 
 type Link struct {
-	ID         int64
-	ShortID    string
-	Href       string
-	CreatedAt  time.Time
-	UsageCount int64
-	UsageAt    time.Time
+	ID             int64
+	ShortID        string
+	Href           string
+	CreatedAt      time.Time
+	UsageCount     int64
+	UsageAt        time.Time
+	PasswordHash   string
+	MaxClicks      int64
+	Rules          []RedirectRule
+	Variants       []Variant
+	StickyVariants bool
+	QueryOptions   QueryOptions
+	DeletedAt      time.Time
+	Title          string
+	Description    string
+	Tags           []string
+	Metadata       map[string]string
+	WorkspaceID    int64
+}
+
+type TagCount struct {
+	Tag   string
+	Links int64
+}
+
+// LinkQuota is how many links of a workspace are not deleted and how many
+// may be; a zero MaxLinks is no limit.
+type LinkQuota struct {
+	MaxLinks int64
+	Links    int64
+}
+
+func (q LinkQuota) Allows(n int64) bool {
+	return q.MaxLinks == 0 || q.Links+n <= q.MaxLinks
+}
+
+func (l Link) IsProtected() bool {
+	return l.PasswordHash != ""
+}
+
+func (l Link) IsDeleted() bool {
+	return !l.DeletedAt.IsZero()
+}
+
+func (l Link) IsExhausted() bool {
+	return l.MaxClicks > 0 && l.UsageCount >= l.MaxClicks
 }
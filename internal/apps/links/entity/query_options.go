@@ -0,0 +1,20 @@
+package entity
+
+const (
+	QueryConflictKeepTarget = "keep_target"
+	QueryConflictOverride   = "override"
+	QueryConflictAppend     = "append"
+)
+
+// QueryOptions controls merging of the incoming query string into the destination.
+// Zero values (nil Passthrough, empty Conflict, nil Allowlist) inherit the global policy;
+// an empty non-nil Allowlist lets every parameter through.
+type QueryOptions struct {
+	Passthrough *bool
+	Conflict    string
+	Allowlist   []string
+}
+
+func (o QueryOptions) IsZero() bool {
+	return o.Passthrough == nil && o.Conflict == "" && o.Allowlist == nil
+}
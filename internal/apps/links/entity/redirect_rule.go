@@ -0,0 +1,10 @@
+package entity
+
+// RedirectRule sends matching visitors to Href. Empty condition lists match everyone;
+// non-empty ones must all match.
+type RedirectRule struct {
+	Languages []string
+	Devices   []string
+	Countries []string
+	Href      string
+}
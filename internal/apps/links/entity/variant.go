@@ -0,0 +1,13 @@
+package entity
+
+// Variant is one of several weighted destinations of a link used for A/B splits.
+type Variant struct {
+	Name   string
+	Href   string
+	Weight int
+}
+
+type VariantStats struct {
+	Variant string
+	Clicks  int64
+}
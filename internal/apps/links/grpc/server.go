@@ -0,0 +1,239 @@
+package grpc
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	linksv1 "github.com/kirillismad/go-url-shortener/internal/pb/links/v1"
+	grpcx "github.com/kirillismad/go-url-shortener/internal/pkg/grpc"
+	pkg_usecase "github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Server struct {
+	linksv1.UnimplementedLinkServiceServer
+
+	createLink   usecase.ICreateLinkHandler
+	getLink      usecase.IGetLinkByShortIDHandler
+	getLinkStats usecase.IGetLinkStatsHandler
+	updateLink   usecase.IUpdateLinkHandler
+	deleteLink   usecase.IDeleteLinkHandler
+}
+
+type ServerParams struct {
+	CreateLink   usecase.ICreateLinkHandler
+	GetLink      usecase.IGetLinkByShortIDHandler
+	GetLinkStats usecase.IGetLinkStatsHandler
+	UpdateLink   usecase.IUpdateLinkHandler
+	DeleteLink   usecase.IDeleteLinkHandler
+}
+
+func NewServer(params ServerParams) *Server {
+	return &Server{
+		createLink:   params.CreateLink,
+		getLink:      params.GetLink,
+		getLinkStats: params.GetLinkStats,
+		updateLink:   params.UpdateLink,
+		deleteLink:   params.DeleteLink,
+	}
+}
+
+func (s *Server) CreateLink(ctx context.Context, req *linksv1.CreateLinkRequest) (*linksv1.CreateLinkResponse, error) {
+	result, err := s.createLink.Handle(ctx, usecase.CreateLinkData{
+		Href:           req.GetHref(),
+		Password:       req.GetPassword(),
+		MaxClicks:      req.GetMaxClicks(),
+		Rules:          toRedirectRuleData(req.GetRules()),
+		Variants:       toVariantData(req.GetVariants()),
+		StickyVariants: req.GetStickyVariants(),
+		QueryOptions:   toQueryOptionsData(req.GetQueryOptions()),
+		Title:          req.GetTitle(),
+		Description:    req.GetDescription(),
+		Tags:           req.GetTags(),
+		Metadata:       req.GetMetadata(),
+	})
+	if err != nil {
+		return nil, grpcx.HandleError(ctx, err)
+	}
+	return &linksv1.CreateLinkResponse{
+		ShortId:   result.ShortID,
+		ShortLink: "/s/" + result.ShortID,
+	}, nil
+}
+
+func (s *Server) ResolveLink(ctx context.Context, req *linksv1.ResolveLinkRequest) (*linksv1.ResolveLinkResponse, error) {
+	query, err := url.ParseQuery(req.GetQuery())
+	if err != nil {
+		return nil, grpcx.HandleError(ctx, pkg_usecase.NewErrValidation("Invalid query", err))
+	}
+
+	result, err := s.getLink.Handle(ctx, usecase.GetLinkByShortIDData{
+		ShortID:     req.GetShortId(),
+		Host:        grpcx.RequestHost(ctx),
+		AccessToken: req.GetAccessToken(),
+		Variant:     req.GetVariant(),
+		Visitor: usecase.Visitor{
+			AcceptLanguage: req.GetAcceptLanguage(),
+			UserAgent:      req.GetUserAgent(),
+			IP:             req.GetIp(),
+		},
+		PathSuffix: req.GetPathSuffix(),
+		Query:      query,
+	})
+	if err != nil {
+		return nil, grpcx.HandleError(ctx, err)
+	}
+	return &linksv1.ResolveLinkResponse{
+		Href:          result.Href,
+		Variant:       result.Variant,
+		StickyVariant: result.StickyVariant,
+	}, nil
+}
+
+func (s *Server) GetLinkStats(ctx context.Context, req *linksv1.GetLinkStatsRequest) (*linksv1.GetLinkStatsResponse, error) {
+	result, err := s.getLinkStats.Handle(ctx, usecase.GetLinkStatsData{
+		ShortID: req.GetShortId(),
+	})
+	if err != nil {
+		return nil, grpcx.HandleError(ctx, err)
+	}
+
+	resp := &linksv1.GetLinkStatsResponse{
+		ShortId:    result.ShortID,
+		UsageCount: result.UsageCount,
+		UsageAt:    timestamppb.New(result.UsageAt),
+		Variants:   make([]*linksv1.VariantStats, 0, len(result.Variants)),
+	}
+	for _, v := range result.Variants {
+		resp.Variants = append(resp.Variants, &linksv1.VariantStats{Name: v.Variant, Clicks: v.Clicks})
+	}
+	return resp, nil
+}
+
+func (s *Server) UpdateLink(ctx context.Context, req *linksv1.UpdateLinkRequest) (*linksv1.UpdateLinkResponse, error) {
+	data := usecase.UpdateLinkData{
+		ShortID:        req.GetShortId(),
+		Href:           req.Href,
+		Password:       req.Password,
+		MaxClicks:      req.MaxClicks,
+		StickyVariants: req.StickyVariants,
+		Title:          req.Title,
+		Description:    req.Description,
+	}
+	if req.Rules != nil {
+		rules := toRedirectRuleData(req.Rules.GetRules())
+		data.Rules = &rules
+	}
+	if req.Variants != nil {
+		variants := toVariantData(req.Variants.GetVariants())
+		data.Variants = &variants
+	}
+	if req.QueryOptions != nil {
+		queryOptions := toQueryOptionsData(req.QueryOptions)
+		data.QueryOptions = &queryOptions
+	}
+	// An empty wrapper clears the tags or metadata of the link.
+	if req.Tags != nil {
+		tags := append([]string{}, req.Tags.GetTags()...)
+		data.Tags = &tags
+	}
+	if req.Metadata != nil {
+		metadata := make(map[string]string, len(req.Metadata.GetMetadata()))
+		for k, v := range req.Metadata.GetMetadata() {
+			metadata[k] = v
+		}
+		data.Metadata = &metadata
+	}
+
+	result, err := s.updateLink.Handle(ctx, data)
+	if err != nil {
+		return nil, grpcx.HandleError(ctx, err)
+	}
+	return &linksv1.UpdateLinkResponse{Link: toLinkMessage(result.Link)}, nil
+}
+
+func (s *Server) DeleteLink(ctx context.Context, req *linksv1.DeleteLinkRequest) (*linksv1.DeleteLinkResponse, error) {
+	err := s.deleteLink.Handle(ctx, usecase.DeleteLinkData{
+		ShortID: req.GetShortId(),
+	})
+	if err != nil {
+		return nil, grpcx.HandleError(ctx, err)
+	}
+	return &linksv1.DeleteLinkResponse{}, nil
+}
+
+func toRedirectRuleData(rules []*linksv1.RedirectRule) []usecase.RedirectRuleData {
+	result := make([]usecase.RedirectRuleData, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, usecase.RedirectRuleData{
+			Languages: r.GetLanguages(),
+			Devices:   r.GetDevices(),
+			Countries: r.GetCountries(),
+			Href:      r.GetHref(),
+		})
+	}
+	return result
+}
+
+func toVariantData(variants []*linksv1.Variant) []usecase.VariantData {
+	result := make([]usecase.VariantData, 0, len(variants))
+	for _, v := range variants {
+		result = append(result, usecase.VariantData{
+			Name:   v.GetName(),
+			Href:   v.GetHref(),
+			Weight: int(v.GetWeight()),
+		})
+	}
+	return result
+}
+
+func toQueryOptionsData(o *linksv1.QueryOptions) usecase.QueryOptionsData {
+	if o == nil {
+		return usecase.QueryOptionsData{}
+	}
+	return usecase.QueryOptionsData{
+		Passthrough: o.Passthrough,
+		Conflict:    o.GetConflict(),
+		Allowlist:   o.GetAllowlist(),
+	}
+}
+
+func toLinkMessage(link entity.Link) *linksv1.Link {
+	msg := &linksv1.Link{
+		ShortId:        link.ShortID,
+		ShortLink:      "/s/" + link.ShortID,
+		Href:           link.Href,
+		CreatedAt:      timestamppb.New(link.CreatedAt),
+		UsageCount:     link.UsageCount,
+		Protected:      link.IsProtected(),
+		MaxClicks:      link.MaxClicks,
+		StickyVariants: link.StickyVariants,
+		QueryOptions: &linksv1.QueryOptions{
+			Passthrough: link.QueryOptions.Passthrough,
+			Conflict:    link.QueryOptions.Conflict,
+			Allowlist:   link.QueryOptions.Allowlist,
+		},
+		Title:       link.Title,
+		Description: link.Description,
+		Tags:        link.Tags,
+		Metadata:    link.Metadata,
+	}
+	for _, r := range link.Rules {
+		msg.Rules = append(msg.Rules, &linksv1.RedirectRule{
+			Languages: r.Languages,
+			Devices:   r.Devices,
+			Countries: r.Countries,
+			Href:      r.Href,
+		})
+	}
+	for _, v := range link.Variants {
+		msg.Variants = append(msg.Variants, &linksv1.Variant{
+			Name:   v.Name,
+			Href:   v.Href,
+			Weight: int32(v.Weight),
+		})
+	}
+	return msg
+}
@@ -0,0 +1,178 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	linksv1 "github.com/kirillismad/go-url-shortener/internal/pb/links/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+type createLinkFunc func(ctx context.Context, data usecase.CreateLinkData) (usecase.CreateLinkResult, error)
+
+func (f createLinkFunc) Handle(ctx context.Context, data usecase.CreateLinkData) (usecase.CreateLinkResult, error) {
+	return f(ctx, data)
+}
+
+type updateLinkFunc func(ctx context.Context, data usecase.UpdateLinkData) (usecase.UpdateLinkResult, error)
+
+func (f updateLinkFunc) Handle(ctx context.Context, data usecase.UpdateLinkData) (usecase.UpdateLinkResult, error) {
+	return f(ctx, data)
+}
+
+type getLinkFunc func(ctx context.Context, data usecase.GetLinkByShortIDData) (usecase.GetLinkByShortIDResult, error)
+
+func (f getLinkFunc) Handle(ctx context.Context, data usecase.GetLinkByShortIDData) (usecase.GetLinkByShortIDResult, error) {
+	return f(ctx, data)
+}
+
+func TestCreateLink(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *linksv1.CreateLinkRequest
+		data usecase.CreateLinkData
+	}{
+		{
+			name: "only href",
+			req:  &linksv1.CreateLinkRequest{Href: "https://example.com"},
+			data: usecase.CreateLinkData{
+				Href:     "https://example.com",
+				Rules:    []usecase.RedirectRuleData{},
+				Variants: []usecase.VariantData{},
+			},
+		},
+		{
+			name: "all fields",
+			req: &linksv1.CreateLinkRequest{
+				Href:           "https://example.com",
+				Password:       "secret",
+				MaxClicks:      1,
+				Rules:          []*linksv1.RedirectRule{{Languages: []string{"de"}, Href: "https://example.de"}},
+				Variants:       []*linksv1.Variant{{Name: "a", Href: "https://a.example.com", Weight: 1}, {Name: "b", Href: "https://b.example.com", Weight: 2}},
+				StickyVariants: true,
+				QueryOptions:   &linksv1.QueryOptions{Passthrough: proto.Bool(true), Conflict: "override", Allowlist: []string{"utm_*"}},
+				Title:          "Spring sale",
+				Description:    "Landing page of the spring campaign",
+				Tags:           []string{"sale", "spring"},
+				Metadata:       map[string]string{"owner": "marketing"},
+			},
+			data: usecase.CreateLinkData{
+				Href:           "https://example.com",
+				Password:       "secret",
+				MaxClicks:      1,
+				Rules:          []usecase.RedirectRuleData{{Languages: []string{"de"}, Href: "https://example.de"}},
+				Variants:       []usecase.VariantData{{Name: "a", Href: "https://a.example.com", Weight: 1}, {Name: "b", Href: "https://b.example.com", Weight: 2}},
+				StickyVariants: true,
+				QueryOptions:   usecase.QueryOptionsData{Passthrough: proto.Bool(true), Conflict: "override", Allowlist: []string{"utm_*"}},
+				Title:          "Spring sale",
+				Description:    "Landing page of the spring campaign",
+				Tags:           []string{"sale", "spring"},
+				Metadata:       map[string]string{"owner": "marketing"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		var data usecase.CreateLinkData
+		server := NewServer(ServerParams{
+			CreateLink: createLinkFunc(func(ctx context.Context, d usecase.CreateLinkData) (usecase.CreateLinkResult, error) {
+				data = d
+				return usecase.CreateLinkResult{ShortID: "abc1234"}, nil
+			}),
+		})
+
+		resp, err := server.CreateLink(context.Background(), tt.req)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.data, data, tt.name)
+		require.Equal(t, "abc1234", resp.GetShortId(), tt.name)
+		require.Equal(t, "/s/abc1234", resp.GetShortLink(), tt.name)
+	}
+}
+
+func TestUpdateLink(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *linksv1.UpdateLinkRequest
+		data usecase.UpdateLinkData
+	}{
+		{
+			name: "nothing set",
+			req:  &linksv1.UpdateLinkRequest{ShortId: "abc1234"},
+			data: usecase.UpdateLinkData{ShortID: "abc1234"},
+		},
+		{
+			name: "details set",
+			req: &linksv1.UpdateLinkRequest{
+				ShortId:     "abc1234",
+				Title:       proto.String("Spring sale"),
+				Description: proto.String(""),
+				Tags:        &linksv1.Tags{Tags: []string{"sale"}},
+				Metadata:    &linksv1.Metadata{Metadata: map[string]string{"owner": "marketing"}},
+			},
+			data: usecase.UpdateLinkData{
+				ShortID:     "abc1234",
+				Title:       proto.String("Spring sale"),
+				Description: proto.String(""),
+				Tags:        &[]string{"sale"},
+				Metadata:    &map[string]string{"owner": "marketing"},
+			},
+		},
+		{
+			name: "tags and metadata cleared",
+			req: &linksv1.UpdateLinkRequest{
+				ShortId:  "abc1234",
+				Tags:     &linksv1.Tags{},
+				Metadata: &linksv1.Metadata{},
+			},
+			data: usecase.UpdateLinkData{
+				ShortID:  "abc1234",
+				Tags:     &[]string{},
+				Metadata: &map[string]string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		var data usecase.UpdateLinkData
+		link := entity.Link{
+			ShortID:  "abc1234",
+			Href:     "https://example.com",
+			Title:    "Spring sale",
+			Tags:     []string{"sale"},
+			Metadata: map[string]string{"owner": "marketing"},
+		}
+		server := NewServer(ServerParams{
+			UpdateLink: updateLinkFunc(func(ctx context.Context, d usecase.UpdateLinkData) (usecase.UpdateLinkResult, error) {
+				data = d
+				return usecase.UpdateLinkResult{Link: link}, nil
+			}),
+		})
+
+		resp, err := server.UpdateLink(context.Background(), tt.req)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.data, data, tt.name)
+		require.Equal(t, "Spring sale", resp.GetLink().GetTitle(), tt.name)
+		require.Equal(t, []string{"sale"}, resp.GetLink().GetTags(), tt.name)
+		require.Equal(t, map[string]string{"owner": "marketing"}, resp.GetLink().GetMetadata(), tt.name)
+	}
+}
+
+func TestResolveLink(t *testing.T) {
+	var data usecase.GetLinkByShortIDData
+	server := NewServer(ServerParams{
+		GetLink: getLinkFunc(func(ctx context.Context, d usecase.GetLinkByShortIDData) (usecase.GetLinkByShortIDResult, error) {
+			data = d
+			return usecase.GetLinkByShortIDResult{Href: "https://example.com"}, nil
+		}),
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(":authority", "Go.Acme.com:443"))
+	resp, err := server.ResolveLink(ctx, &linksv1.ResolveLinkRequest{ShortId: "abc1234", Ip: "192.0.2.1"})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com", resp.GetHref())
+	require.Equal(t, "abc1234", data.ShortID)
+	require.Equal(t, "go.acme.com", data.Host)
+	require.Equal(t, "192.0.2.1", data.Visitor.IP)
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	pkg_usecase "github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type AuditActorOutput struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
+
+type AuditEntryOutput struct {
+	ID        int64            `json:"id"`
+	ShortID   string           `json:"shortId"`
+	Action    string           `json:"action"`
+	Actor     AuditActorOutput `json:"actor"`
+	OldValue  json.RawMessage  `json:"oldValue"`
+	NewValue  json.RawMessage  `json:"newValue"`
+	CreatedAt time.Time        `json:"createdAt"`
+}
+
+type AuditEntriesOutput struct {
+	Entries    []AuditEntryOutput `json:"entries"`
+	NextBefore *int64             `json:"nextBefore,omitempty"`
+}
+
+func toAuditEntriesOutput(result usecase.ListAuditEntriesResult) AuditEntriesOutput {
+	output := AuditEntriesOutput{Entries: make([]AuditEntryOutput, 0, len(result.Entries))}
+	for _, e := range result.Entries {
+		entry := AuditEntryOutput{
+			ID:        e.ID,
+			ShortID:   e.ShortID,
+			Action:    e.Action,
+			Actor:     AuditActorOutput{Type: e.ActorType, ID: e.ActorID},
+			OldValue:  json.RawMessage("null"),
+			NewValue:  json.RawMessage("null"),
+			CreatedAt: e.CreatedAt,
+		}
+		if e.OldValue != nil {
+			entry.OldValue = e.OldValue
+		}
+		if e.NewValue != nil {
+			entry.NewValue = e.NewValue
+		}
+		output.Entries = append(output.Entries, entry)
+	}
+	if result.NextBefore != 0 {
+		output.NextBefore = &result.NextBefore
+	}
+	return output
+}
+
+// auditPage holds the paging and time filters shared by the audit endpoints.
+type auditPage struct {
+	since  time.Time
+	until  time.Time
+	before int64
+	limit  int32
+}
+
+func parseAuditPage(query url.Values) (auditPage, error) {
+	var page auditPage
+	var err error
+	if v := query.Get("since"); v != "" {
+		if page.since, err = time.Parse(time.RFC3339, v); err != nil {
+			return auditPage{}, pkg_usecase.NewErrValidation("Invalid since", err)
+		}
+	}
+	if v := query.Get("until"); v != "" {
+		if page.until, err = time.Parse(time.RFC3339, v); err != nil {
+			return auditPage{}, pkg_usecase.NewErrValidation("Invalid until", err)
+		}
+	}
+	if v := query.Get("before"); v != "" {
+		if page.before, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return auditPage{}, pkg_usecase.NewErrValidation("Invalid before", err)
+		}
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return auditPage{}, pkg_usecase.NewErrValidation("Invalid limit", err)
+		}
+		page.limit = int32(limit)
+	}
+	return page, nil
+}
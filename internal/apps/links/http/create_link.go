@@ -7,8 +7,37 @@ import (
 	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
 )
 
+type RedirectRuleInput struct {
+	Languages []string `json:"languages"`
+	Devices   []string `json:"devices"`
+	Countries []string `json:"countries"`
+	Href      string   `json:"href"`
+}
+
+type VariantInput struct {
+	Name   string `json:"name"`
+	Href   string `json:"href"`
+	Weight int    `json:"weight"`
+}
+
+type QueryOptionsInput struct {
+	Passthrough *bool    `json:"passthrough"`
+	Conflict    string   `json:"conflict"`
+	Allowlist   []string `json:"allowlist"`
+}
+
 type CreateLinkInput struct {
-	Href string `json:"href"`
+	Href           string              `json:"href"`
+	Password       string              `json:"password"`
+	MaxClicks      int64               `json:"maxClicks"`
+	Rules          []RedirectRuleInput `json:"rules"`
+	Variants       []VariantInput      `json:"variants"`
+	StickyVariants bool                `json:"stickyVariants"`
+	QueryOptions   QueryOptionsInput   `json:"queryOptions"`
+	Title          string              `json:"title"`
+	Description    string              `json:"description"`
+	Tags           []string            `json:"tags"`
+	Metadata       map[string]string   `json:"metadata"`
 }
 
 type CreateLinkOutput struct {
@@ -28,14 +57,33 @@ func NewCreateLinkHandler(usecase usecase.ICreateLinkHandler) *CreateLinkHandler
 func (h *CreateLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	input, err := httpx.ReadJson[CreateLinkInput](ctx, r)
+	input, err := httpx.ReadJsonOrForm[CreateLinkInput](ctx, r)
 	if err != nil {
 		httpx.HandleError(ctx, w, err)
 		return
 	}
 
+	rules := make([]usecase.RedirectRuleData, 0, len(input.Rules))
+	for _, r := range input.Rules {
+		rules = append(rules, usecase.RedirectRuleData(r))
+	}
+	variants := make([]usecase.VariantData, 0, len(input.Variants))
+	for _, v := range input.Variants {
+		variants = append(variants, usecase.VariantData(v))
+	}
+
 	result, err := h.usecase.Handle(ctx, usecase.CreateLinkData{
-		Href: input.Href,
+		Href:           input.Href,
+		Password:       input.Password,
+		MaxClicks:      input.MaxClicks,
+		Rules:          rules,
+		Variants:       variants,
+		StickyVariants: input.StickyVariants,
+		QueryOptions:   usecase.QueryOptionsData(input.QueryOptions),
+		Title:          input.Title,
+		Description:    input.Description,
+		Tags:           input.Tags,
+		Metadata:       input.Metadata,
 	})
 	if err != nil {
 		httpx.HandleError(ctx, w, err)
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type DeleteLinkHandler struct {
+	usecase usecase.IDeleteLinkHandler
+}
+
+func NewDeleteLinkHandler(usecase usecase.IDeleteLinkHandler) *DeleteLinkHandler {
+	return &DeleteLinkHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *DeleteLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.usecase.Handle(ctx, usecase.DeleteLinkData{
+		ShortID: r.PathValue("short_id"),
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type GetLinkHistoryHandler struct {
+	usecase usecase.IGetLinkHistoryHandler
+}
+
+func NewGetLinkHistoryHandler(usecase usecase.IGetLinkHistoryHandler) *GetLinkHistoryHandler {
+	return &GetLinkHistoryHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetLinkHistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	page, err := parseAuditPage(query)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.GetLinkHistoryData{
+		ShortID: r.PathValue("short_id"),
+		Action:  query.Get("action"),
+		Since:   page.since,
+		Until:   page.until,
+		Before:  page.before,
+		Limit:   page.limit,
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toAuditEntriesOutput(result))
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type VariantStatsOutput struct {
+	Name   string `json:"name"`
+	Clicks int64  `json:"clicks"`
+}
+
+type GetLinkStatsOutput struct {
+	ShortID    string               `json:"shortId"`
+	UsageCount int64                `json:"usageCount"`
+	UsageAt    time.Time            `json:"usageAt"`
+	Variants   []VariantStatsOutput `json:"variants"`
+}
+
+type GetLinkStatsHandler struct {
+	usecase usecase.IGetLinkStatsHandler
+}
+
+func NewGetLinkStatsHandler(usecase usecase.IGetLinkStatsHandler) *GetLinkStatsHandler {
+	return &GetLinkStatsHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetLinkStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx, usecase.GetLinkStatsData{
+		ShortID: r.PathValue("short_id"),
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := GetLinkStatsOutput{
+		ShortID:    result.ShortID,
+		UsageCount: result.UsageCount,
+		UsageAt:    result.UsageAt,
+		Variants:   make([]VariantStatsOutput, 0, len(result.Variants)),
+	}
+	for _, v := range result.Variants {
+		output.Variants = append(output.Variants, VariantStatsOutput{Name: v.Variant, Clicks: v.Clicks})
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ShortIDStatsOutput struct {
+	Length        int     `json:"length"`
+	MinLength     int     `json:"minLength"`
+	MaxLength     int     `json:"maxLength"`
+	AlphabetSize  int     `json:"alphabetSize"`
+	Capacity      float64 `json:"capacity"`
+	Links         *int64  `json:"links"`
+	Utilization   float64 `json:"utilization"`
+	CollisionRate float64 `json:"collisionRate"`
+}
+
+type GetShortIDStatsHandler struct {
+	usecase usecase.IGetShortIDStatsHandler
+}
+
+func NewGetShortIDStatsHandler(usecase usecase.IGetShortIDStatsHandler) *GetShortIDStatsHandler {
+	return &GetShortIDStatsHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetShortIDStatsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ShortIDStatsOutput{
+		Length:        result.Len,
+		MinLength:     result.MinLen,
+		MaxLength:     result.MaxLen,
+		AlphabetSize:  result.AlphabetSize,
+		Capacity:      result.Capacity,
+		Utilization:   result.Utilization,
+		CollisionRate: result.CollisionRate,
+	}
+	if result.Links >= 0 {
+		output.Links = &result.Links
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
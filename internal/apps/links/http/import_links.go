@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ImportLinkInput struct {
+	Href string `json:"href"`
+}
+
+type ImportLinksInput struct {
+	Links []ImportLinkInput `json:"links"`
+}
+
+type ImportedLinkOutput struct {
+	ShortLink string `json:"shortLink"`
+	Href      string `json:"href"`
+}
+
+type ImportLinksOutput struct {
+	Links []ImportedLinkOutput `json:"links"`
+}
+
+type ImportLinksHandler struct {
+	usecase usecase.IImportLinksHandler
+}
+
+func NewImportLinksHandler(usecase usecase.IImportLinksHandler) *ImportLinksHandler {
+	return &ImportLinksHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ImportLinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[ImportLinksInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	links := make([]usecase.ImportLinkData, 0, len(input.Links))
+	for _, l := range input.Links {
+		links = append(links, usecase.ImportLinkData(l))
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.ImportLinksData{Links: links})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ImportLinksOutput{Links: make([]ImportedLinkOutput, 0, len(result.Links))}
+	for _, l := range result.Links {
+		output.Links = append(output.Links, ImportedLinkOutput{ShortLink: "/s/" + l.ShortID, Href: l.Href})
+	}
+	httpx.WriteJson(ctx, w, http.StatusCreated, output)
+}
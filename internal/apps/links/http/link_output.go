@@ -0,0 +1,58 @@
+package http
+
+import (
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+type LinkOutput struct {
+	ShortID        string              `json:"shortId"`
+	ShortLink      string              `json:"shortLink"`
+	Href           string              `json:"href"`
+	CreatedAt      time.Time           `json:"createdAt"`
+	UsageCount     int64               `json:"usageCount"`
+	Protected      bool                `json:"protected"`
+	MaxClicks      int64               `json:"maxClicks,omitempty"`
+	Rules          []RedirectRuleInput `json:"rules"`
+	Variants       []VariantInput      `json:"variants"`
+	StickyVariants bool                `json:"stickyVariants"`
+	QueryOptions   QueryOptionsInput   `json:"queryOptions"`
+	Title          string              `json:"title"`
+	Description    string              `json:"description"`
+	Tags           []string            `json:"tags"`
+	Metadata       map[string]string   `json:"metadata"`
+}
+
+func toLinkOutput(link entity.Link) LinkOutput {
+	output := LinkOutput{
+		ShortID:        link.ShortID,
+		ShortLink:      "/s/" + link.ShortID,
+		Href:           link.Href,
+		CreatedAt:      link.CreatedAt,
+		UsageCount:     link.UsageCount,
+		Protected:      link.IsProtected(),
+		MaxClicks:      link.MaxClicks,
+		Rules:          make([]RedirectRuleInput, 0, len(link.Rules)),
+		Variants:       make([]VariantInput, 0, len(link.Variants)),
+		StickyVariants: link.StickyVariants,
+		QueryOptions:   QueryOptionsInput(link.QueryOptions),
+		Title:          link.Title,
+		Description:    link.Description,
+		Tags:           link.Tags,
+		Metadata:       link.Metadata,
+	}
+	if output.Tags == nil {
+		output.Tags = []string{}
+	}
+	if output.Metadata == nil {
+		output.Metadata = map[string]string{}
+	}
+	for _, r := range link.Rules {
+		output.Rules = append(output.Rules, RedirectRuleInput(r))
+	}
+	for _, v := range link.Variants {
+		output.Variants = append(output.Variants, VariantInput(v))
+	}
+	return output
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ListAuditEntriesHandler struct {
+	usecase usecase.IListAuditEntriesHandler
+}
+
+func NewListAuditEntriesHandler(usecase usecase.IListAuditEntriesHandler) *ListAuditEntriesHandler {
+	return &ListAuditEntriesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListAuditEntriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	page, err := parseAuditPage(query)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.ListAuditEntriesData{
+		ShortID:   query.Get("short_id"),
+		Action:    query.Get("action"),
+		ActorType: query.Get("actor_type"),
+		ActorID:   query.Get("actor_id"),
+		Since:     page.since,
+		Until:     page.until,
+		Before:    page.before,
+		Limit:     page.limit,
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toAuditEntriesOutput(result))
+}
@@ -0,0 +1,83 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+	pkg_usecase "github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ListLinksOutput struct {
+	Links      []LinkOutput `json:"links"`
+	NextBefore *int64       `json:"nextBefore,omitempty"`
+}
+
+type ListLinksHandler struct {
+	usecase usecase.IListLinksHandler
+}
+
+func NewListLinksHandler(usecase usecase.IListLinksHandler) *ListLinksHandler {
+	return &ListLinksHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListLinksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	data, err := parseListLinksQuery(r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, data)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListLinksOutput{Links: make([]LinkOutput, 0, len(result.Links))}
+	for _, link := range result.Links {
+		output.Links = append(output.Links, toLinkOutput(link))
+	}
+	if result.NextBefore != 0 {
+		output.NextBefore = &result.NextBefore
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
+
+// parseListLinksQuery reads repeated tag=... and metadata=key:value filters.
+func parseListLinksQuery(r *http.Request) (usecase.ListLinksData, error) {
+	query := r.URL.Query()
+	data := usecase.ListLinksData{Tags: query["tag"]}
+	for _, pair := range query["metadata"] {
+		key, value, ok := strings.Cut(pair, ":")
+		if !ok {
+			return usecase.ListLinksData{}, pkg_usecase.NewErrValidation("Invalid metadata", errors.New("metadata filter must be key:value"))
+		}
+		if data.Metadata == nil {
+			data.Metadata = make(map[string]string)
+		}
+		data.Metadata[key] = value
+	}
+	if v := query.Get("before"); v != "" {
+		before, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return usecase.ListLinksData{}, pkg_usecase.NewErrValidation("Invalid before", err)
+		}
+		data.Before = before
+	}
+	if v := query.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return usecase.ListLinksData{}, pkg_usecase.NewErrValidation("Invalid limit", err)
+		}
+		data.Limit = int32(limit)
+	}
+	return data, nil
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type TagCountOutput struct {
+	Tag   string `json:"tag"`
+	Links int64  `json:"links"`
+}
+
+type ListTagsOutput struct {
+	Tags []TagCountOutput `json:"tags"`
+}
+
+type ListTagsHandler struct {
+	usecase usecase.IListTagsHandler
+}
+
+func NewListTagsHandler(usecase usecase.IListTagsHandler) *ListTagsHandler {
+	return &ListTagsHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListTagsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListTagsOutput{Tags: make([]TagCountOutput, 0, len(result.Tags))}
+	for _, t := range result.Tags {
+		output.Tags = append(output.Tags, TagCountOutput(t))
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
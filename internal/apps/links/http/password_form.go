@@ -0,0 +1,43 @@
+package http
+
+import (
+	"html/template"
+	"net/http"
+)
+
+const accessCookieName = "link_access"
+
+var passwordFormTemplate = template.Must(template.New("password_form").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Protected link</title>
+</head>
+<body>
+<form method="post" action="{{.Action}}">
+<p>This link is password protected.</p>
+{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+<input type="password" name="password" autocomplete="current-password" required autofocus>
+<button type="submit">Open</button>
+</form>
+</body>
+</html>
+`))
+
+func linkCookiePath(shortID string) string {
+	return "/s/" + shortID
+}
+
+type passwordForm struct {
+	Action string
+	Error  string
+}
+
+func writePasswordForm(w http.ResponseWriter, status int, form passwordForm) {
+	w.Header().Set("content-type", "text/html; charset=utf-8")
+	w.Header().Set("cache-control", "no-store")
+	w.WriteHeader(status)
+	passwordFormTemplate.Execute(w, form)
+}
@@ -1,12 +1,18 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
 	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
 )
 
+const (
+	variantCookieName   = "link_variant"
+	variantCookieMaxAge = 30 * 24 * 60 * 60
+)
+
 type RedirectHandler struct {
 	usecase usecase.IGetLinkByShortIDHandler
 }
@@ -22,14 +28,48 @@ func (h *RedirectHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	short_id := r.PathValue("short_id")
 
+	var accessToken, variant string
+	if c, err := r.Cookie(accessCookieName); err == nil {
+		accessToken = c.Value
+	}
+	if c, err := r.Cookie(variantCookieName); err == nil {
+		variant = c.Value
+	}
+
 	result, err := h.usecase.Handle(ctx, usecase.GetLinkByShortIDData{
-		ShortID: short_id,
+		ShortID:     short_id,
+		Host:        httpx.RequestHost(r),
+		AccessToken: accessToken,
+		Variant:     variant,
+		Visitor: usecase.Visitor{
+			AcceptLanguage: r.Header.Get("accept-language"),
+			UserAgent:      r.UserAgent(),
+			IP:             httpx.ClientIP(r),
+		},
+		PathSuffix: r.PathValue("path"),
+		Query:      r.URL.Query(),
 	})
+	if errors.Is(err, usecase.ErrPasswordRequired) {
+		writePasswordForm(w, http.StatusUnauthorized, passwordForm{Action: r.URL.RequestURI()})
+		return
+	}
 	if err != nil {
 		httpx.HandleError(ctx, w, err)
 		return
 	}
 
+	if result.StickyVariant {
+		http.SetCookie(w, &http.Cookie{
+			Name:     variantCookieName,
+			Value:    result.Variant,
+			Path:     linkCookiePath(short_id),
+			MaxAge:   variantCookieMaxAge,
+			Secure:   r.TLS != nil,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+
 	w.Header().Set("location", result.Href)
 	w.WriteHeader(http.StatusTemporaryRedirect)
 }
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type RestoreLinkHandler struct {
+	usecase usecase.IRestoreLinkHandler
+}
+
+func NewRestoreLinkHandler(usecase usecase.IRestoreLinkHandler) *RestoreLinkHandler {
+	return &RestoreLinkHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RestoreLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx, usecase.RestoreLinkData{
+		ShortID: r.PathValue("short_id"),
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toLinkOutput(result.Link))
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+	usecasex "github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type UnlockLinkHandler struct {
+	usecase usecase.IUnlockLinkHandler
+}
+
+func NewUnlockLinkHandler(usecase usecase.IUnlockLinkHandler) *UnlockLinkHandler {
+	return &UnlockLinkHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *UnlockLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	short_id := r.PathValue("short_id")
+
+	result, err := h.usecase.Handle(ctx, usecase.UnlockLinkData{
+		ShortID:   short_id,
+		Password:  r.PostFormValue("password"),
+		ClientKey: httpx.ClientIP(r),
+	})
+	var errValidation usecasex.ErrValidation
+	switch {
+	case errors.Is(err, usecase.ErrInvalidPassword):
+		writePasswordForm(w, http.StatusUnauthorized, passwordForm{Action: r.URL.RequestURI(), Error: "Wrong password."})
+		return
+	case errors.Is(err, usecasex.ErrTooManyRequests):
+		writePasswordForm(w, http.StatusTooManyRequests, passwordForm{Action: r.URL.RequestURI(), Error: "Too many attempts, try again later."})
+		return
+	case errors.As(err, &errValidation):
+		writePasswordForm(w, http.StatusBadRequest, passwordForm{Action: r.URL.RequestURI(), Error: "Enter the password."})
+		return
+	case err != nil:
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	if result.AccessToken != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:     accessCookieName,
+			Value:    result.AccessToken,
+			Path:     linkCookiePath(short_id),
+			Expires:  result.ExpiresAt,
+			Secure:   r.TLS != nil,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+	}
+	http.Redirect(w, r, r.URL.RequestURI(), http.StatusSeeOther)
+}
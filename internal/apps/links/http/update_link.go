@@ -0,0 +1,80 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type UpdateLinkInput struct {
+	Href           *string              `json:"href"`
+	Password       *string              `json:"password"`
+	MaxClicks      *int64               `json:"maxClicks"`
+	Rules          *[]RedirectRuleInput `json:"rules"`
+	Variants       *[]VariantInput      `json:"variants"`
+	StickyVariants *bool                `json:"stickyVariants"`
+	QueryOptions   *QueryOptionsInput   `json:"queryOptions"`
+	Title          *string              `json:"title"`
+	Description    *string              `json:"description"`
+	Tags           *[]string            `json:"tags"`
+	Metadata       *map[string]string   `json:"metadata"`
+}
+
+type UpdateLinkHandler struct {
+	usecase usecase.IUpdateLinkHandler
+}
+
+func NewUpdateLinkHandler(usecase usecase.IUpdateLinkHandler) *UpdateLinkHandler {
+	return &UpdateLinkHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *UpdateLinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[UpdateLinkInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	data := usecase.UpdateLinkData{
+		ShortID:        r.PathValue("short_id"),
+		Href:           input.Href,
+		Password:       input.Password,
+		MaxClicks:      input.MaxClicks,
+		StickyVariants: input.StickyVariants,
+		Title:          input.Title,
+		Description:    input.Description,
+		Tags:           input.Tags,
+		Metadata:       input.Metadata,
+	}
+	if input.Rules != nil {
+		rules := make([]usecase.RedirectRuleData, 0, len(*input.Rules))
+		for _, r := range *input.Rules {
+			rules = append(rules, usecase.RedirectRuleData(r))
+		}
+		data.Rules = &rules
+	}
+	if input.Variants != nil {
+		variants := make([]usecase.VariantData, 0, len(*input.Variants))
+		for _, v := range *input.Variants {
+			variants = append(variants, usecase.VariantData(v))
+		}
+		data.Variants = &variants
+	}
+	if input.QueryOptions != nil {
+		queryOptions := usecase.QueryOptionsData(*input.QueryOptions)
+		data.QueryOptions = &queryOptions
+	}
+
+	result, err := h.usecase.Handle(ctx, data)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toLinkOutput(result.Link))
+}
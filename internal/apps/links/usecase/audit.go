@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+// linkAuditValue is the state of a link kept in the audit log. The password
+// hash is left out; whether a link is protected is enough to see a change.
+type linkAuditValue struct {
+	Href           string                   `json:"href"`
+	Protected      bool                     `json:"protected"`
+	MaxClicks      int64                    `json:"maxClicks,omitempty"`
+	Rules          []redirectRuleAuditValue `json:"rules,omitempty"`
+	Variants       []variantAuditValue      `json:"variants,omitempty"`
+	StickyVariants bool                     `json:"stickyVariants,omitempty"`
+	QueryOptions   *queryOptionsAuditValue  `json:"queryOptions,omitempty"`
+	DeletedAt      *time.Time               `json:"deletedAt,omitempty"`
+	Title          string                   `json:"title,omitempty"`
+	Description    string                   `json:"description,omitempty"`
+	Tags           []string                 `json:"tags,omitempty"`
+	Metadata       map[string]string        `json:"metadata,omitempty"`
+}
+
+type redirectRuleAuditValue struct {
+	Languages []string `json:"languages,omitempty"`
+	Devices   []string `json:"devices,omitempty"`
+	Countries []string `json:"countries,omitempty"`
+	Href      string   `json:"href"`
+}
+
+type variantAuditValue struct {
+	Name   string `json:"name"`
+	Href   string `json:"href"`
+	Weight int    `json:"weight"`
+}
+
+type queryOptionsAuditValue struct {
+	Passthrough *bool    `json:"passthrough,omitempty"`
+	Conflict    string   `json:"conflict,omitempty"`
+	Allowlist   []string `json:"allowlist,omitempty"`
+}
+
+func toLinkAuditValue(link entity.Link) linkAuditValue {
+	value := linkAuditValue{
+		Href:           link.Href,
+		Protected:      link.IsProtected(),
+		MaxClicks:      link.MaxClicks,
+		StickyVariants: link.StickyVariants,
+		Title:          link.Title,
+		Description:    link.Description,
+		Tags:           link.Tags,
+		Metadata:       link.Metadata,
+	}
+	for _, r := range link.Rules {
+		value.Rules = append(value.Rules, redirectRuleAuditValue(r))
+	}
+	for _, v := range link.Variants {
+		value.Variants = append(value.Variants, variantAuditValue(v))
+	}
+	if !link.QueryOptions.IsZero() {
+		options := queryOptionsAuditValue(link.QueryOptions)
+		value.QueryOptions = &options
+	}
+	if link.IsDeleted() {
+		value.DeletedAt = &link.DeletedAt
+	}
+	return value
+}
+
+// newAuditEntry records the change of a link from old to new by the actor of
+// ctx. A nil old or new means the link did not exist before or after.
+func newAuditEntry(ctx context.Context, action string, old, new *entity.Link) CreateAuditEntryArgs {
+	args := CreateAuditEntryArgs{
+		Action: action,
+		Actor:  usecase.ActorFrom(ctx),
+	}
+	if old != nil {
+		args.WorkspaceID, args.LinkID, args.ShortID = old.WorkspaceID, old.ID, old.ShortID
+		args.OldValue = toLinkAuditValue(*old)
+	}
+	if new != nil {
+		args.WorkspaceID, args.LinkID, args.ShortID = new.WorkspaceID, new.ID, new.ShortID
+		args.NewValue = toLinkAuditValue(*new)
+	}
+	return args
+}
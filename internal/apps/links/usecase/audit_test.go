@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	actor := usecase.Actor{Type: usecase.ActorUser, ID: "alice"}
+	ctx := usecase.WithActor(context.Background(), actor)
+	old := entity.Link{ID: 1, ShortID: "abc", Href: "https://example.com", PasswordHash: "$2a$10$secret"}
+	deleted := old
+	deleted.DeletedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	entry := newAuditEntry(ctx, entity.AuditActionDelete, &old, &deleted)
+	require.Equal(t, int64(1), entry.LinkID)
+	require.Equal(t, "abc", entry.ShortID)
+	require.Equal(t, actor, entry.Actor)
+
+	oldValue, err := json.Marshal(entry.OldValue)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"href": "https://example.com", "protected": true}`, string(oldValue))
+	newValue, err := json.Marshal(entry.NewValue)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"href": "https://example.com", "protected": true, "deletedAt": "2024-01-02T03:04:05Z"}`, string(newValue))
+
+	entry = newAuditEntry(context.Background(), entity.AuditActionCreate, nil, &old)
+	require.Nil(t, entry.OldValue)
+	require.Equal(t, usecase.ActorAnonymous, entry.Actor.Type)
+}
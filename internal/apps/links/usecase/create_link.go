@@ -4,15 +4,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math/rand"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
 	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type CreateLinkData struct {
-	Href string `validate:"required,http_url"`
+	Href           string             `validate:"required,http_url"`
+	Password       string             `validate:"omitempty,min=4,max=72"`
+	MaxClicks      int64              `validate:"omitempty,min=1"`
+	Rules          []RedirectRuleData `validate:"omitempty,max=20,dive"`
+	Variants       []VariantData      `validate:"omitempty,min=2,max=10,unique=Name,dive"`
+	StickyVariants bool
+	QueryOptions   QueryOptionsData
+	Title          string            `validate:"max=200"`
+	Description    string            `validate:"max=2000"`
+	Tags           []string          `validate:"max=20,dive,min=1,max=64"`
+	Metadata       map[string]string `validate:"max=50,dive,keys,min=1,max=64,endkeys,max=1024"`
 }
 
 type CreateLinkResult struct {
@@ -24,42 +34,79 @@ type ICreateLinkHandler interface {
 }
 
 type CreateLinkHandler struct {
-	repoFactory usecase.RepoFactory[LinkRepo]
-	validator   *validator.Validate
-	shortIDLen  int
-	alphabet    []rune
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	shortIDs     ShortIDGenerator
+	recentWrites *usecase.RecentWrites
 }
 
 type CreateLinkParams struct {
-	RepoFactory usecase.RepoFactory[LinkRepo]
-	Validator   *validator.Validate
-	ShortIDLen  int
-	Alphabet    []rune
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	ShortIDs     ShortIDGenerator
+	RecentWrites *usecase.RecentWrites
 }
 
 func NewCreateLinkHandler(params CreateLinkParams) ICreateLinkHandler {
 	return &CreateLinkHandler{
-		repoFactory: params.RepoFactory,
-		validator:   params.Validator,
-		shortIDLen:  params.ShortIDLen,
-		alphabet:    params.Alphabet,
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		shortIDs:     params.ShortIDs,
+		recentWrites: params.RecentWrites,
 	}
 }
 
 func (h *CreateLinkHandler) Handle(ctx context.Context, data CreateLinkData) (CreateLinkResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleEditor)
+	if err != nil {
+		return CreateLinkResult{}, err
+	}
+	data.Tags = normalizeTags(data.Tags)
 	if err := h.validator.StructCtx(ctx, &data); err != nil {
 		return CreateLinkResult{}, usecase.NewErrValidation("Invalid request", err)
 	}
+	for _, rule := range data.Rules {
+		if !rule.hasConditions() {
+			return CreateLinkResult{}, usecase.NewErrValidation("Invalid request", errors.New("redirect rule without conditions"))
+		}
+	}
+
+	var passwordHash string
+	if data.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(data.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return CreateLinkResult{}, fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
+		}
+		passwordHash = string(hash)
+	}
+	queryOptions := entity.QueryOptions(data.QueryOptions)
+	// Only plain links are shared between callers shortening the same href;
+	// tags and metadata belong to whoever set them.
+	reusable := passwordHash == "" && data.MaxClicks == 0 && len(data.Rules) == 0 && len(data.Variants) == 0 && queryOptions.IsZero() &&
+		data.Title == "" && data.Description == "" && len(data.Tags) == 0 && len(data.Metadata) == 0
 
 	var link entity.Link
-	err := h.repoFactory.InTransaction(ctx, func(repo LinkRepo) error {
+	err = h.repoFactory.InTransaction(ctx, func(repo LinkRepo) error {
 		var txErr error
-		link, txErr = repo.GetLinkByHref(ctx, data.Href)
-		if txErr == nil {
-			return nil
+		if reusable {
+			link, txErr = repo.GetReusableLinkByHref(ctx, GetReusableLinkByHrefArgs{
+				WorkspaceID: principal.WorkspaceID,
+				Href:        data.Href,
+			})
+			if txErr == nil {
+				return nil
+			}
+			if !errors.Is(txErr, usecase.ErrNoResult) {
+				return fmt.Errorf("repo.GetReusableLinkByHref: %w", txErr)
+			}
+		}
+
+		quota, txErr := repo.GetLinkQuota(ctx, principal.WorkspaceID)
+		if txErr != nil {
+			return fmt.Errorf("repo.GetLinkQuota: %w", txErr)
 		}
-		if !errors.Is(txErr, usecase.ErrNoResult) {
-			return fmt.Errorf("repo.GetLinkByHref: %w", txErr)
+		if !quota.Allows(1) {
+			return ErrQuotaExceeded
 		}
 
 		shortID, txErr := h.generateUniqueShortID(ctx, repo)
@@ -68,38 +115,63 @@ func (h *CreateLinkHandler) Handle(ctx context.Context, data CreateLinkData) (Cr
 		}
 
 		link, txErr = repo.CreateLink(ctx, CreateLinkArgs{
-			ShortID: shortID,
-			Href:    data.Href,
+			WorkspaceID:    principal.WorkspaceID,
+			ShortID:        shortID,
+			Href:           data.Href,
+			PasswordHash:   passwordHash,
+			Reusable:       reusable,
+			MaxClicks:      data.MaxClicks,
+			Rules:          toRedirectRules(data.Rules),
+			Variants:       toVariants(data.Variants),
+			StickyVariants: data.StickyVariants,
+			QueryOptions:   queryOptions,
+			Title:          data.Title,
+			Description:    data.Description,
+			Tags:           data.Tags,
+			Metadata:       data.Metadata,
 		})
 		if txErr != nil {
 			return fmt.Errorf("repo.CreateLink: %w", txErr)
 		}
-		return txErr
+
+		txErr = repo.CreateEvent(ctx, newLinkEvent(entity.EventLinkCreated, link))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateEvent: %w", txErr)
+		}
+
+		txErr = repo.CreateAuditEntry(ctx, newAuditEntry(ctx, entity.AuditActionCreate, nil, &link))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateAuditEntry: %w", txErr)
+		}
+		return nil
 	})
 	if err != nil {
 		return CreateLinkResult{}, err
 	}
+	h.recentWrites.Add(link.ShortID)
 	return CreateLinkResult{ShortID: link.ShortID}, nil
 }
 
-func (h *CreateLinkHandler) generateShortID() string {
-	b := make([]rune, 0, h.shortIDLen)
-	for i := 0; i < h.shortIDLen; i++ {
-		idx := rand.Intn(len(h.alphabet))
-		b = append(b, h.alphabet[idx])
-	}
-	return string(b)
-}
+// maxShortIDAttempts bounds the IDs drawn per link. When they are all taken
+// the short ID space is nearly full and cannot grow (len has reached
+// max_len), so the request fails instead of querying forever while holding
+// the quota lock.
+const maxShortIDAttempts = 100
 
 func (h *CreateLinkHandler) generateUniqueShortID(ctx context.Context, repo LinkRepo) (string, error) {
-	for {
-		shortID := h.generateShortID()
-		exists, err := repo.IsLinkExistByShortID(ctx, shortID)
+	for range maxShortIDAttempts {
+		shortID, err := h.shortIDs.Generate()
+		if err != nil {
+			return "", fmt.Errorf("shortIDs.Generate: %w", err)
+		}
+		taken, err := repo.IsShortIDTaken(ctx, shortID)
 		if err != nil {
-			return "", fmt.Errorf("repo.IsLinkExistByShortID: %w", err)
+			return "", fmt.Errorf("repo.IsShortIDTaken: %w", err)
 		}
-		if !exists {
+		h.shortIDs.Observe(taken)
+		if !taken {
 			return shortID, nil
 		}
 	}
+	return "", ErrShortIDsExhausted
 }
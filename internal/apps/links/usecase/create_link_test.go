@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+// collidingShortIDs always generates the same ID.
+type collidingShortIDs struct {
+	observed int
+}
+
+func (g *collidingShortIDs) Generate() (string, error) {
+	return "abc1234", nil
+}
+
+func (g *collidingShortIDs) Observe(taken bool) {
+	g.observed++
+}
+
+type allTakenRepo struct {
+	LinkRepo
+	queries int
+}
+
+func (r *allTakenRepo) IsShortIDTaken(ctx context.Context, shortID string) (bool, error) {
+	r.queries++
+	return true, nil
+}
+
+func (r *allTakenRepo) ListTakenShortIDs(ctx context.Context, shortIDs []string) ([]string, error) {
+	r.queries++
+	return shortIDs, nil
+}
+
+func TestGenerateUniqueShortIDExhausted(t *testing.T) {
+	r := require.New(t)
+
+	shortIDs := &collidingShortIDs{}
+	h := &CreateLinkHandler{shortIDs: shortIDs}
+	repo := &allTakenRepo{}
+
+	_, err := h.generateUniqueShortID(context.Background(), repo)
+	r.ErrorIs(err, ErrShortIDsExhausted)
+	r.ErrorIs(err, usecase.ErrUnavailable)
+	r.Equal(maxShortIDAttempts, repo.queries)
+	r.Equal(maxShortIDAttempts, shortIDs.observed)
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type DeleteLinkData struct {
+	ShortID string `validate:"required,short_id"`
+}
+
+type IDeleteLinkHandler interface {
+	Handle(ctx context.Context, data DeleteLinkData) error
+}
+
+type DeleteLinkHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	recentWrites *usecase.RecentWrites
+}
+
+type DeleteLinkParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	RecentWrites *usecase.RecentWrites
+}
+
+func NewDeleteLinkHandler(params DeleteLinkParams) IDeleteLinkHandler {
+	return &DeleteLinkHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		recentWrites: params.RecentWrites,
+	}
+}
+
+func (h *DeleteLinkHandler) Handle(ctx context.Context, data DeleteLinkData) error {
+	principal, err := usecase.Authorize(ctx, usecase.RoleEditor)
+	if err != nil {
+		return err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return usecase.NewErrValidation("Invalid link format", err)
+	}
+
+	err = h.repoFactory.InTransaction(ctx, func(repo LinkRepo) error {
+		link, txErr := repo.GetLinkByShortIDForUpdate(ctx, WorkspaceShortID{
+			WorkspaceID: principal.WorkspaceID,
+			ShortID:     data.ShortID,
+		})
+		if txErr != nil {
+			return txErr
+		}
+		if link.IsDeleted() {
+			return ErrLinkDeleted
+		}
+
+		deleted, txErr := repo.SoftDeleteLink(ctx, link.ID)
+		if txErr != nil {
+			return fmt.Errorf("repo.SoftDeleteLink: %w", txErr)
+		}
+
+		txErr = repo.CreateEvent(ctx, newLinkEvent(entity.EventLinkDeleted, deleted))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateEvent: %w", txErr)
+		}
+
+		txErr = repo.CreateAuditEntry(ctx, newAuditEntry(ctx, entity.AuditActionDelete, &link, &deleted))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateAuditEntry: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	h.recentWrites.Add(data.ShortID)
+	return nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+const pathPlaceholder = "{path}"
+
+// QueryPolicy is the effective query passthrough configuration for a redirect.
+type QueryPolicy struct {
+	Passthrough bool
+	Conflict    string
+	Allowlist   []string
+}
+
+type QueryOptionsData struct {
+	Passthrough *bool
+	Conflict    string   `validate:"omitempty,oneof=keep_target override append"`
+	Allowlist   []string `validate:"omitempty,max=50,dive,required,max=64"`
+}
+
+func (p QueryPolicy) withOptions(o entity.QueryOptions) QueryPolicy {
+	if o.Passthrough != nil {
+		p.Passthrough = *o.Passthrough
+	}
+	if o.Conflict != "" {
+		p.Conflict = o.Conflict
+	}
+	if o.Allowlist != nil {
+		p.Allowlist = o.Allowlist
+	}
+	return p
+}
+
+func (p QueryPolicy) allows(key string) bool {
+	if len(p.Allowlist) == 0 {
+		return true
+	}
+	for _, allowed := range p.Allowlist {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok && strings.HasPrefix(key, prefix) {
+			return true
+		}
+		if allowed == key {
+			return true
+		}
+	}
+	return false
+}
+
+func buildDestination(href string, pathSuffix string, query url.Values, policy QueryPolicy) (string, error) {
+	dest, err := expandPath(href, pathSuffix)
+	if err != nil {
+		return "", err
+	}
+	if !policy.Passthrough || len(query) == 0 {
+		return dest, nil
+	}
+
+	u, err := url.Parse(dest)
+	if err != nil {
+		return "", fmt.Errorf("url.Parse: %w", err)
+	}
+	target := u.Query()
+	merged := false
+	for key, values := range query {
+		if !policy.allows(key) {
+			continue
+		}
+		switch policy.Conflict {
+		case entity.QueryConflictOverride:
+			target[key] = values
+		case entity.QueryConflictAppend:
+			target[key] = append(target[key], values...)
+		default:
+			if _, ok := target[key]; ok {
+				continue
+			}
+			target[key] = values
+		}
+		merged = true
+	}
+	if !merged {
+		return dest, nil
+	}
+	u.RawQuery = target.Encode()
+	return u.String(), nil
+}
+
+func expandPath(href string, pathSuffix string) (string, error) {
+	if !strings.Contains(href, pathPlaceholder) {
+		if pathSuffix != "" {
+			return "", ErrPathSuffixNotSupported
+		}
+		return href, nil
+	}
+
+	segments := strings.Split(pathSuffix, "/")
+	for i, segment := range segments {
+		if segment == "." || segment == ".." {
+			return "", ErrPathSuffixNotSupported
+		}
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.ReplaceAll(href, pathPlaceholder, strings.Join(segments, "/")), nil
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDestination(t *testing.T) {
+	tests := []struct {
+		name       string
+		href       string
+		pathSuffix string
+		query      string
+		policy     QueryPolicy
+		exp        string
+		err        error
+	}{
+		{
+			name:   "passthrough disabled",
+			href:   "https://site/page?x=1",
+			query:  "utm_source=tw",
+			policy: QueryPolicy{},
+			exp:    "https://site/page?x=1",
+		},
+		{
+			name:   "merge",
+			href:   "https://site/page?x=1",
+			query:  "utm_source=tw",
+			policy: QueryPolicy{Passthrough: true},
+			exp:    "https://site/page?utm_source=tw&x=1",
+		},
+		{
+			name:   "keep target on conflict",
+			href:   "https://site/page?x=1",
+			query:  "x=2",
+			policy: QueryPolicy{Passthrough: true, Conflict: entity.QueryConflictKeepTarget},
+			exp:    "https://site/page?x=1",
+		},
+		{
+			name:   "override on conflict",
+			href:   "https://site/page?x=1",
+			query:  "x=2",
+			policy: QueryPolicy{Passthrough: true, Conflict: entity.QueryConflictOverride},
+			exp:    "https://site/page?x=2",
+		},
+		{
+			name:   "append on conflict",
+			href:   "https://site/page?x=1",
+			query:  "x=2",
+			policy: QueryPolicy{Passthrough: true, Conflict: entity.QueryConflictAppend},
+			exp:    "https://site/page?x=1&x=2",
+		},
+		{
+			name:   "allowlist",
+			href:   "https://site/page",
+			query:  "utm_source=tw&utm_medium=social&session=abc&ref=x",
+			policy: QueryPolicy{Passthrough: true, Allowlist: []string{"utm_*", "ref"}},
+			exp:    "https://site/page?ref=x&utm_medium=social&utm_source=tw",
+		},
+		{
+			name:       "path template",
+			href:       "https://site/docs/{path}?x=1",
+			pathSuffix: "rest/of path",
+			exp:        "https://site/docs/rest/of%20path?x=1",
+		},
+		{
+			name:       "dot segments",
+			href:       "https://site/docs/{path}",
+			pathSuffix: "../admin",
+			err:        ErrPathSuffixNotSupported,
+		},
+		{
+			name:       "suffix without template",
+			href:       "https://site/page",
+			pathSuffix: "rest",
+			err:        ErrPathSuffixNotSupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			query, err := url.ParseQuery(tt.query)
+			r.NoError(err)
+
+			dest, err := buildDestination(tt.href, tt.pathSuffix, query, tt.policy)
+
+			if tt.err != nil {
+				r.ErrorIs(err, tt.err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tt.exp, dest)
+		})
+	}
+}
+
+func TestQueryPolicyWithOptions(t *testing.T) {
+	r := require.New(t)
+
+	disabled := false
+	global := QueryPolicy{Passthrough: true, Conflict: entity.QueryConflictKeepTarget, Allowlist: []string{"utm_*"}}
+
+	r.Equal(global, global.withOptions(entity.QueryOptions{}))
+	r.Equal(
+		QueryPolicy{Passthrough: false, Conflict: entity.QueryConflictOverride, Allowlist: []string{}},
+		global.withOptions(entity.QueryOptions{Passthrough: &disabled, Conflict: entity.QueryConflictOverride, Allowlist: []string{}}),
+	)
+}
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+var (
+	ErrPasswordRequired  = usecase.NewError(usecase.ErrUnauthorized, "password_required", "password required")
+	ErrInvalidPassword   = usecase.NewError(usecase.ErrUnauthorized, "invalid_password", "invalid password")
+	ErrLinkExhausted     = usecase.NewError(usecase.ErrGone, "link_exhausted", "link click limit reached")
+	ErrLinkDeleted       = usecase.NewError(usecase.ErrGone, "link_deleted", "link was deleted")
+	ErrRestoreExpired    = usecase.NewError(usecase.ErrGone, "restore_window_expired", "link was deleted too long ago to be restored")
+	ErrQuotaExceeded     = usecase.NewError(usecase.ErrForbidden, "link_quota_exceeded", "workspace link quota exceeded")
+	ErrShortIDsExhausted = usecase.NewError(usecase.ErrUnavailable, "short_ids_exhausted", "no free short ID found, the short ID space is nearly full")
+
+	ErrPathSuffixNotSupported = usecase.NewError(usecase.ErrNoResult, "path_suffix_not_supported", "path suffix not supported")
+)
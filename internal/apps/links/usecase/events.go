@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+type linkEventPayload struct {
+	ShortID    string    `json:"shortId"`
+	Href       string    `json:"href"`
+	CreatedAt  time.Time `json:"createdAt"`
+	UsageCount int64     `json:"usageCount"`
+	MaxClicks  int64     `json:"maxClicks,omitempty"`
+	Threshold  int64     `json:"threshold,omitempty"`
+}
+
+func newLinkEvent(eventType string, link entity.Link) CreateEventArgs {
+	return CreateEventArgs{
+		WorkspaceID: link.WorkspaceID,
+		Type:        eventType,
+		LinkID:      link.ID,
+		Payload: linkEventPayload{
+			ShortID:    link.ShortID,
+			Href:       link.Href,
+			CreatedAt:  link.CreatedAt,
+			UsageCount: link.UsageCount,
+			MaxClicks:  link.MaxClicks,
+		},
+	}
+}
+
+func newClickThresholdEvent(link entity.Link, threshold int64) CreateEventArgs {
+	event := newLinkEvent(entity.EventLinkClickThresholdReached, link)
+	payload := event.Payload.(linkEventPayload)
+	payload.Threshold = threshold
+	event.Payload = payload
+	return event
+}
@@ -2,6 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/url"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
@@ -10,10 +14,20 @@ import (
 
 type GetLinkByShortIDData struct {
 	ShortID string `validate:"required,short_id"`
+	// Host is the requested host without a port. On a workspace's custom
+	// domain only the links of that workspace resolve.
+	Host        string
+	AccessToken string
+	Variant     string
+	Visitor     Visitor
+	PathSuffix  string
+	Query       url.Values
 }
 
 type GetLinkByShortIDResult struct {
-	Href string
+	Href          string
+	Variant       string
+	StickyVariant bool
 }
 
 type IGetLinkByShortIDHandler interface {
@@ -21,19 +35,36 @@ type IGetLinkByShortIDHandler interface {
 }
 
 type GetLinkByShortIDHandler struct {
-	repoFactory usecase.RepoFactory[LinkRepo]
-	validator   *validator.Validate
+	repoFactory     usecase.RepoFactory[LinkRepo]
+	validator       *validator.Validate
+	accessSigner    AccessSigner
+	countryResolver CountryResolver
+	queryPolicy     QueryPolicy
+	clickThresholds []int64
+	recentWrites    *usecase.RecentWrites
+	intn            func(n int) int
 }
 
 type GetLinkByShortIDParams struct {
-	RepoFactory usecase.RepoFactory[LinkRepo]
-	Validator   *validator.Validate
+	RepoFactory     usecase.RepoFactory[LinkRepo]
+	Validator       *validator.Validate
+	AccessSigner    AccessSigner
+	CountryResolver CountryResolver
+	QueryPolicy     QueryPolicy
+	ClickThresholds []int64
+	RecentWrites    *usecase.RecentWrites
 }
 
 func NewGetLinkByShortIDHandler(params GetLinkByShortIDParams) IGetLinkByShortIDHandler {
 	return &GetLinkByShortIDHandler{
-		repoFactory: params.RepoFactory,
-		validator:   params.Validator,
+		repoFactory:     params.RepoFactory,
+		validator:       params.Validator,
+		accessSigner:    params.AccessSigner,
+		countryResolver: params.CountryResolver,
+		queryPolicy:     params.QueryPolicy,
+		clickThresholds: params.ClickThresholds,
+		recentWrites:    params.RecentWrites,
+		intn:            rand.Intn,
 	}
 }
 
@@ -42,22 +73,106 @@ func (h *GetLinkByShortIDHandler) Handle(ctx context.Context, data GetLinkByShor
 		return GetLinkByShortIDResult{}, usecase.NewErrValidation("Invalid link format", err)
 	}
 
-	var link entity.Link
-	err := h.repoFactory.InTransaction(ctx, func(r LinkRepo) error {
+	link, err := h.repoFactory.GetReadRepo(h.recentWrites.ReadContext(ctx, data.ShortID)).GetLinkByShortID(ctx, data.ShortID)
+	if err != nil {
+		return GetLinkByShortIDResult{}, err
+	}
+
+	if err := h.checkDomain(ctx, data.Host, link); err != nil {
+		return GetLinkByShortIDResult{}, err
+	}
+
+	if link.IsDeleted() {
+		return GetLinkByShortIDResult{}, ErrLinkDeleted
+	}
+	if link.IsExhausted() {
+		return GetLinkByShortIDResult{}, ErrLinkExhausted
+	}
+
+	if link.IsProtected() && !h.accessSigner.Verify(data.AccessToken, accessTokenValue(link.ShortID)) {
+		return GetLinkByShortIDResult{}, ErrPasswordRequired
+	}
+
+	var result GetLinkByShortIDResult
+	switch rule, ok := matchRedirectRule(ctx, h.countryResolver, link.Rules, data.Visitor); {
+	case ok:
+		result = GetLinkByShortIDResult{Href: rule.Href}
+	case len(link.Variants) > 0:
+		variant := pickVariant(link.Variants, data.Variant, h.intn)
+		result = GetLinkByShortIDResult{
+			Href:          variant.Href,
+			Variant:       variant.Name,
+			StickyVariant: link.StickyVariants,
+		}
+	default:
+		result = GetLinkByShortIDResult{Href: link.Href}
+	}
+
+	result.Href, err = buildDestination(result.Href, data.PathSuffix, data.Query, h.queryPolicy.withOptions(link.QueryOptions))
+	if err != nil {
+		return GetLinkByShortIDResult{}, err
+	}
+
+	// The link may come from a lagging replica; the usage update on the
+	// primary is what enforces the click limit.
+	err = h.repoFactory.InTransaction(ctx, func(r LinkRepo) error {
 		var txErr error
-		link, txErr = r.GetLinkByShortID(ctx, data.ShortID)
+		link.UsageCount, txErr = r.UpdateLinkUsageInfo(ctx, link.ID)
 		if txErr != nil {
 			return txErr
 		}
 
-		txErr = r.UpdateLinkUsageInfo(ctx, link.ID)
+		txErr = r.CreateLinkClick(ctx, CreateLinkClickArgs{
+			WorkspaceID: link.WorkspaceID,
+			LinkID:      link.ID,
+			Variant:     result.Variant,
+		})
 		if txErr != nil {
-			return txErr
+			return fmt.Errorf("repo.CreateLinkClick: %w", txErr)
+		}
+
+		for _, event := range h.usageEvents(link) {
+			txErr = r.CreateEvent(ctx, event)
+			if txErr != nil {
+				return fmt.Errorf("repo.CreateEvent: %w", txErr)
+			}
 		}
 		return nil
 	})
 	if err != nil {
 		return GetLinkByShortIDResult{}, err
 	}
-	return GetLinkByShortIDResult{Href: link.Href}, nil
+	return result, nil
+}
+
+// checkDomain hides the links of other workspaces on a custom domain. Hosts
+// that are not registered serve every link.
+func (h *GetLinkByShortIDHandler) checkDomain(ctx context.Context, host string, link entity.Link) error {
+	if host == "" {
+		return nil
+	}
+	workspaceID, err := h.repoFactory.GetReadRepo(ctx).GetWorkspaceIDByDomain(ctx, host)
+	if errors.Is(err, usecase.ErrNoResult) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("repo.GetWorkspaceIDByDomain: %w", err)
+	}
+	if workspaceID != link.WorkspaceID {
+		return usecase.ErrNoResult
+	}
+	return nil
+}
+
+func (h *GetLinkByShortIDHandler) usageEvents(link entity.Link) []CreateEventArgs {
+	var events []CreateEventArgs
+	for _, threshold := range h.clickThresholds {
+		if link.UsageCount == threshold {
+			events = append(events, newClickThresholdEvent(link, threshold))
+		}
+	}
+	if link.MaxClicks > 0 && link.UsageCount == link.MaxClicks {
+		events = append(events, newLinkEvent(entity.EventLinkExpired, link))
+	}
+	return events
 }
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type GetLinkHistoryData struct {
+	ShortID string `validate:"required,short_id"`
+	Action  string `validate:"omitempty,oneof=create update delete restore purge"`
+	Since   time.Time
+	Until   time.Time
+	Before  int64 `validate:"min=0"`
+	Limit   int32 `validate:"min=0,max=500"`
+}
+
+type IGetLinkHistoryHandler interface {
+	Handle(ctx context.Context, data GetLinkHistoryData) (ListAuditEntriesResult, error)
+}
+
+// GetLinkHistoryHandler lists the audit entries of one short ID, including
+// those of a purged link.
+type GetLinkHistoryHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	recentWrites *usecase.RecentWrites
+}
+
+type GetLinkHistoryParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	RecentWrites *usecase.RecentWrites
+}
+
+func NewGetLinkHistoryHandler(params GetLinkHistoryParams) IGetLinkHistoryHandler {
+	return &GetLinkHistoryHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		recentWrites: params.RecentWrites,
+	}
+}
+
+func (h *GetLinkHistoryHandler) Handle(ctx context.Context, data GetLinkHistoryData) (ListAuditEntriesResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleViewer)
+	if err != nil {
+		return ListAuditEntriesResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return ListAuditEntriesResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	repo := h.repoFactory.GetReadRepo(h.recentWrites.ReadContext(ctx, data.ShortID))
+	found, err := repo.IsShortIDInWorkspace(ctx, WorkspaceShortID{
+		WorkspaceID: principal.WorkspaceID,
+		ShortID:     data.ShortID,
+	})
+	if err != nil {
+		return ListAuditEntriesResult{}, fmt.Errorf("repo.IsShortIDInWorkspace: %w", err)
+	}
+	if !found {
+		return ListAuditEntriesResult{}, usecase.ErrNoResult
+	}
+
+	return listAuditEntries(ctx, repo, principal.WorkspaceID, ListAuditEntriesData{
+		ShortID: data.ShortID,
+		Action:  data.Action,
+		Since:   data.Since,
+		Until:   data.Until,
+		Before:  data.Before,
+		Limit:   data.Limit,
+	})
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type GetLinkStatsData struct {
+	ShortID string `validate:"required,short_id"`
+}
+
+type GetLinkStatsResult struct {
+	ShortID    string
+	UsageCount int64
+	UsageAt    time.Time
+	Variants   []entity.VariantStats
+}
+
+type IGetLinkStatsHandler interface {
+	Handle(ctx context.Context, data GetLinkStatsData) (GetLinkStatsResult, error)
+}
+
+type GetLinkStatsHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	recentWrites *usecase.RecentWrites
+}
+
+type GetLinkStatsParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	RecentWrites *usecase.RecentWrites
+}
+
+func NewGetLinkStatsHandler(params GetLinkStatsParams) IGetLinkStatsHandler {
+	return &GetLinkStatsHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		recentWrites: params.RecentWrites,
+	}
+}
+
+func (h *GetLinkStatsHandler) Handle(ctx context.Context, data GetLinkStatsData) (GetLinkStatsResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleViewer)
+	if err != nil {
+		return GetLinkStatsResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return GetLinkStatsResult{}, usecase.NewErrValidation("Invalid link format", err)
+	}
+
+	repo := h.repoFactory.GetReadRepo(h.recentWrites.ReadContext(ctx, data.ShortID))
+	link, err := repo.GetWorkspaceLinkByShortID(ctx, WorkspaceShortID{
+		WorkspaceID: principal.WorkspaceID,
+		ShortID:     data.ShortID,
+	})
+	if err != nil {
+		return GetLinkStatsResult{}, err
+	}
+	if link.IsDeleted() {
+		return GetLinkStatsResult{}, ErrLinkDeleted
+	}
+
+	variants, err := repo.CountLinkClicksByVariant(ctx, link.ID)
+	if err != nil {
+		return GetLinkStatsResult{}, err
+	}
+
+	return GetLinkStatsResult{
+		ShortID:    link.ShortID,
+		UsageCount: link.UsageCount,
+		UsageAt:    link.UsageAt,
+		Variants:   variants,
+	}, nil
+}
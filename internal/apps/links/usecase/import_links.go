@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ImportLinkData struct {
+	Href string `validate:"required,http_url"`
+}
+
+type ImportLinksData struct {
+	Links []ImportLinkData `validate:"required,min=1,max=10000,dive"`
+}
+
+type ImportedLink struct {
+	ShortID string
+	Href    string
+}
+
+type ImportLinksResult struct {
+	Links []ImportedLink
+}
+
+type IImportLinksHandler interface {
+	Handle(ctx context.Context, data ImportLinksData) (ImportLinksResult, error)
+}
+
+// ImportLinksHandler creates plain links in bulk: the rows are loaded with
+// COPY and the link.created events are written in one batch.
+type ImportLinksHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	shortIDs     ShortIDGenerator
+	recentWrites *usecase.RecentWrites
+}
+
+type ImportLinksParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	ShortIDs     ShortIDGenerator
+	RecentWrites *usecase.RecentWrites
+}
+
+func NewImportLinksHandler(params ImportLinksParams) IImportLinksHandler {
+	return &ImportLinksHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		shortIDs:     params.ShortIDs,
+		recentWrites: params.RecentWrites,
+	}
+}
+
+func (h *ImportLinksHandler) Handle(ctx context.Context, data ImportLinksData) (ImportLinksResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleEditor)
+	if err != nil {
+		return ImportLinksResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, &data); err != nil {
+		return ImportLinksResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	var shortIDs []string
+	err = h.repoFactory.InTransaction(ctx, func(repo LinkRepo) error {
+		quota, txErr := repo.GetLinkQuota(ctx, principal.WorkspaceID)
+		if txErr != nil {
+			return fmt.Errorf("repo.GetLinkQuota: %w", txErr)
+		}
+		if !quota.Allows(int64(len(data.Links))) {
+			return ErrQuotaExceeded
+		}
+
+		shortIDs, txErr = h.generateUniqueShortIDs(ctx, repo, len(data.Links))
+		if txErr != nil {
+			return txErr
+		}
+
+		args := make([]ImportLinkArgs, 0, len(data.Links))
+		for i, link := range data.Links {
+			args = append(args, ImportLinkArgs{
+				WorkspaceID: principal.WorkspaceID,
+				ShortID:     shortIDs[i],
+				Href:        link.Href,
+			})
+		}
+		if _, txErr := repo.ImportLinks(ctx, args); txErr != nil {
+			return fmt.Errorf("repo.ImportLinks: %w", txErr)
+		}
+
+		links, txErr := repo.ListLinksByShortIDs(ctx, shortIDs)
+		if txErr != nil {
+			return fmt.Errorf("repo.ListLinksByShortIDs: %w", txErr)
+		}
+
+		events := make([]CreateEventArgs, 0, len(links))
+		entries := make([]CreateAuditEntryArgs, 0, len(links))
+		for _, link := range links {
+			events = append(events, newLinkEvent(entity.EventLinkCreated, link))
+			entries = append(entries, newAuditEntry(ctx, entity.AuditActionCreate, nil, &link))
+		}
+		if txErr := repo.CreateEvents(ctx, events); txErr != nil {
+			return fmt.Errorf("repo.CreateEvents: %w", txErr)
+		}
+		if txErr := repo.CreateAuditEntries(ctx, entries); txErr != nil {
+			return fmt.Errorf("repo.CreateAuditEntries: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return ImportLinksResult{}, err
+	}
+
+	result := ImportLinksResult{Links: make([]ImportedLink, 0, len(data.Links))}
+	for i, link := range data.Links {
+		h.recentWrites.Add(shortIDs[i])
+		result.Links = append(result.Links, ImportedLink{ShortID: shortIDs[i], Href: link.Href})
+	}
+	return result, nil
+}
+
+// generateUniqueShortIDs draws n distinct IDs and redraws the ones already
+// taken by a link or a purged one, checking each round with a single query.
+// It draws at most maxShortIDAttempts IDs per link.
+func (h *ImportLinksHandler) generateUniqueShortIDs(ctx context.Context, repo LinkRepo, n int) ([]string, error) {
+	shortIDs := make([]string, 0, n)
+	seen := make(map[string]struct{}, n)
+	draws := 0
+	for len(shortIDs) < n {
+		candidates := make([]string, 0, n-len(shortIDs))
+		for len(candidates) < n-len(shortIDs) {
+			if draws == n*maxShortIDAttempts {
+				return nil, ErrShortIDsExhausted
+			}
+			draws++
+			shortID, err := h.shortIDs.Generate()
+			if err != nil {
+				return nil, fmt.Errorf("shortIDs.Generate: %w", err)
+			}
+			if _, ok := seen[shortID]; ok {
+				continue
+			}
+			seen[shortID] = struct{}{}
+			candidates = append(candidates, shortID)
+		}
+
+		existing, err := repo.ListTakenShortIDs(ctx, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ListTakenShortIDs: %w", err)
+		}
+		taken := make(map[string]struct{}, len(existing))
+		for _, shortID := range existing {
+			taken[shortID] = struct{}{}
+		}
+		for _, shortID := range candidates {
+			_, ok := taken[shortID]
+			h.shortIDs.Observe(ok)
+			if !ok {
+				shortIDs = append(shortIDs, shortID)
+			}
+		}
+	}
+	return shortIDs, nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/shortid"
+	"github.com/stretchr/testify/require"
+)
+
+type takenShortIDsRepo struct {
+	LinkRepo
+	taken   map[string]bool
+	queries int
+}
+
+func (r *takenShortIDsRepo) ListTakenShortIDs(ctx context.Context, shortIDs []string) ([]string, error) {
+	r.queries++
+	var taken []string
+	for _, shortID := range shortIDs {
+		if r.taken[shortID] {
+			taken = append(taken, shortID)
+		}
+	}
+	return taken, nil
+}
+
+func TestGenerateUniqueShortIDs(t *testing.T) {
+	r := require.New(t)
+
+	shortIDs, err := shortid.NewPolicy(shortid.PolicyParams{Alphabet: "abcdef", Len: 1})
+	r.NoError(err)
+	h := &ImportLinksHandler{shortIDs: shortIDs}
+	repo := &takenShortIDsRepo{taken: map[string]bool{"a": true, "b": true}}
+
+	generated, err := h.generateUniqueShortIDs(context.Background(), repo, 4)
+	r.NoError(err)
+	r.ElementsMatch([]string{"c", "d", "e", "f"}, generated)
+	r.LessOrEqual(repo.queries, 3)
+}
+
+// sequentialShortIDs generates a new ID on every call.
+type sequentialShortIDs struct {
+	n int
+}
+
+func (g *sequentialShortIDs) Generate() (string, error) {
+	g.n++
+	return fmt.Sprintf("id%05d", g.n), nil
+}
+
+func (g *sequentialShortIDs) Observe(taken bool) {}
+
+func TestGenerateUniqueShortIDsExhausted(t *testing.T) {
+	tests := []struct {
+		name     string
+		shortIDs ShortIDGenerator
+		queries  int
+	}{
+		// Every draw repeats an ID of the batch and never reaches the repo.
+		{name: "same id", shortIDs: &collidingShortIDs{}, queries: 0},
+		// Every draw is new but already taken.
+		{name: "taken ids", shortIDs: &sequentialShortIDs{}, queries: maxShortIDAttempts},
+	}
+	for _, tt := range tests {
+		h := &ImportLinksHandler{shortIDs: tt.shortIDs}
+		repo := &allTakenRepo{}
+
+		_, err := h.generateUniqueShortIDs(context.Background(), repo, 3)
+		require.ErrorIs(t, err, ErrShortIDsExhausted, tt.name)
+		require.Equal(t, tt.queries, repo.queries, tt.name)
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+const defaultAuditEntriesLimit = 100
+
+type ListAuditEntriesData struct {
+	ShortID   string `validate:"omitempty,short_id"`
+	Action    string `validate:"omitempty,oneof=create update delete restore purge"`
+	ActorType string `validate:"omitempty,oneof=user api_key anonymous system"`
+	ActorID   string `validate:"max=256"`
+	Since     time.Time
+	Until     time.Time
+	Before    int64 `validate:"min=0"`
+	Limit     int32 `validate:"min=0,max=500"`
+}
+
+type ListAuditEntriesResult struct {
+	Entries []entity.AuditEntry
+	// NextBefore is the cursor of the next page, 0 on the last one.
+	NextBefore int64
+}
+
+type IListAuditEntriesHandler interface {
+	Handle(ctx context.Context, data ListAuditEntriesData) (ListAuditEntriesResult, error)
+}
+
+// ListAuditEntriesHandler pages through the audit log, newest first.
+type ListAuditEntriesHandler struct {
+	repoFactory usecase.RepoFactory[LinkRepo]
+	validator   *validator.Validate
+}
+
+type ListAuditEntriesParams struct {
+	RepoFactory usecase.RepoFactory[LinkRepo]
+	Validator   *validator.Validate
+}
+
+func NewListAuditEntriesHandler(params ListAuditEntriesParams) IListAuditEntriesHandler {
+	return &ListAuditEntriesHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *ListAuditEntriesHandler) Handle(ctx context.Context, data ListAuditEntriesData) (ListAuditEntriesResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return ListAuditEntriesResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return ListAuditEntriesResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+	return listAuditEntries(ctx, h.repoFactory.GetReadRepo(ctx), principal.WorkspaceID, data)
+}
+
+func listAuditEntries(ctx context.Context, repo LinkRepo, workspaceID int64, data ListAuditEntriesData) (ListAuditEntriesResult, error) {
+	limit := data.Limit
+	if limit == 0 {
+		limit = defaultAuditEntriesLimit
+	}
+	entries, err := repo.ListAuditEntries(ctx, ListAuditEntriesArgs{
+		WorkspaceID: workspaceID,
+		ShortID:     data.ShortID,
+		Action:      data.Action,
+		ActorType:   data.ActorType,
+		ActorID:     data.ActorID,
+		Since:       data.Since,
+		Until:       data.Until,
+		BeforeID:    data.Before,
+		Limit:       limit,
+	})
+	if err != nil {
+		return ListAuditEntriesResult{}, err
+	}
+
+	result := ListAuditEntriesResult{Entries: entries}
+	if len(entries) == int(limit) {
+		result.NextBefore = entries[len(entries)-1].ID
+	}
+	return result, nil
+}
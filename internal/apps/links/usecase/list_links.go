@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+const defaultLinksLimit = 100
+
+type ListLinksData struct {
+	Tags     []string          `validate:"max=20,dive,min=1,max=64"`
+	Metadata map[string]string `validate:"max=50,dive,keys,min=1,max=64,endkeys,max=1024"`
+	Before   int64             `validate:"min=0"`
+	Limit    int32             `validate:"min=0,max=500"`
+}
+
+type ListLinksResult struct {
+	Links []entity.Link
+	// NextBefore is the cursor of the next page, 0 on the last one.
+	NextBefore int64
+}
+
+type IListLinksHandler interface {
+	Handle(ctx context.Context, data ListLinksData) (ListLinksResult, error)
+}
+
+// ListLinksHandler pages through the links that are not deleted, newest
+// first, keeping those with all of the given tags and metadata pairs.
+type ListLinksHandler struct {
+	repoFactory usecase.RepoFactory[LinkRepo]
+	validator   *validator.Validate
+}
+
+type ListLinksParams struct {
+	RepoFactory usecase.RepoFactory[LinkRepo]
+	Validator   *validator.Validate
+}
+
+func NewListLinksHandler(params ListLinksParams) IListLinksHandler {
+	return &ListLinksHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *ListLinksHandler) Handle(ctx context.Context, data ListLinksData) (ListLinksResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleViewer)
+	if err != nil {
+		return ListLinksResult{}, err
+	}
+	data.Tags = normalizeTags(data.Tags)
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return ListLinksResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	limit := data.Limit
+	if limit == 0 {
+		limit = defaultLinksLimit
+	}
+	links, err := h.repoFactory.GetReadRepo(ctx).ListLinks(ctx, ListLinksArgs{
+		WorkspaceID: principal.WorkspaceID,
+		Tags:        data.Tags,
+		Metadata:    data.Metadata,
+		BeforeID:    data.Before,
+		Limit:       limit,
+	})
+	if err != nil {
+		return ListLinksResult{}, err
+	}
+
+	result := ListLinksResult{Links: links}
+	if len(links) == int(limit) {
+		result.NextBefore = links[len(links)-1].ID
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ListTagsResult struct {
+	Tags []entity.TagCount
+}
+
+type IListTagsHandler interface {
+	Handle(ctx context.Context) (ListTagsResult, error)
+}
+
+// ListTagsHandler counts the links that are not deleted per tag, most used
+// first.
+type ListTagsHandler struct {
+	repoFactory usecase.RepoFactory[LinkRepo]
+}
+
+type ListTagsParams struct {
+	RepoFactory usecase.RepoFactory[LinkRepo]
+}
+
+func NewListTagsHandler(params ListTagsParams) IListTagsHandler {
+	return &ListTagsHandler{
+		repoFactory: params.RepoFactory,
+	}
+}
+
+func (h *ListTagsHandler) Handle(ctx context.Context) (ListTagsResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleViewer)
+	if err != nil {
+		return ListTagsResult{}, err
+	}
+	tags, err := h.repoFactory.GetReadRepo(ctx).ListTags(ctx, principal.WorkspaceID)
+	if err != nil {
+		return ListTagsResult{}, err
+	}
+	return ListTagsResult{Tags: tags}, nil
+}
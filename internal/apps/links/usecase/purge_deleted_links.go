@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+var purgeActor = usecase.Actor{Type: usecase.ActorSystem, ID: "deletion_purge"}
+
+type IPurgeDeletedLinksHandler interface {
+	Handle(ctx context.Context) error
+}
+
+// PurgeDeletedLinksHandler removes links deleted longer than the retention
+// ago. Their short IDs are kept as tombstones so they are never issued again,
+// and each purge is recorded in the audit log.
+type PurgeDeletedLinksHandler struct {
+	repoFactory usecase.RepoFactory[LinkRepo]
+	retention   time.Duration
+	batchSize   int
+}
+
+type PurgeDeletedLinksParams struct {
+	RepoFactory usecase.RepoFactory[LinkRepo]
+	Retention   time.Duration
+	BatchSize   int
+}
+
+func NewPurgeDeletedLinksHandler(params PurgeDeletedLinksParams) IPurgeDeletedLinksHandler {
+	return &PurgeDeletedLinksHandler{
+		repoFactory: params.RepoFactory,
+		retention:   params.Retention,
+		batchSize:   params.BatchSize,
+	}
+}
+
+func (h *PurgeDeletedLinksHandler) Handle(ctx context.Context) error {
+	before := time.Now().Add(-h.retention)
+	for {
+		purged, err := h.repoFactory.GetRepo().PurgeDeletedLinks(ctx, PurgeDeletedLinksArgs{
+			Before: before,
+			Limit:  h.batchSize,
+			Actor:  purgeActor,
+		})
+		if err != nil {
+			return fmt.Errorf("repo.PurgeDeletedLinks: %w", err)
+		}
+		if purged < int64(h.batchSize) {
+			return nil
+		}
+	}
+}
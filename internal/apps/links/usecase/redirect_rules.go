@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/useragent"
+	"golang.org/x/text/language"
+)
+
+type Visitor struct {
+	AcceptLanguage string
+	UserAgent      string
+	IP             string
+}
+
+type RedirectRuleData struct {
+	Languages []string `validate:"omitempty,max=20,dive,bcp47_language_tag"`
+	Devices   []string `validate:"omitempty,dive,oneof=ios android desktop"`
+	Countries []string `validate:"omitempty,max=250,dive,iso3166_1_alpha2"`
+	Href      string   `validate:"required,http_url"`
+}
+
+func (d RedirectRuleData) hasConditions() bool {
+	return len(d.Languages) > 0 || len(d.Devices) > 0 || len(d.Countries) > 0
+}
+
+func toRedirectRules(data []RedirectRuleData) []entity.RedirectRule {
+	rules := make([]entity.RedirectRule, 0, len(data))
+	for _, d := range data {
+		rules = append(rules, entity.RedirectRule{
+			Languages: d.Languages,
+			Devices:   d.Devices,
+			Countries: d.Countries,
+			Href:      d.Href,
+		})
+	}
+	return rules
+}
+
+// matchRedirectRule returns the first rule matching the visitor, or false to fall back to link.Href.
+func matchRedirectRule(ctx context.Context, resolver CountryResolver, rules []entity.RedirectRule, visitor Visitor) (entity.RedirectRule, bool) {
+	if len(rules) == 0 {
+		return entity.RedirectRule{}, false
+	}
+
+	lang := preferredLanguage(visitor.AcceptLanguage)
+	device := useragent.Device(visitor.UserAgent)
+
+	var country string
+	var countryResolved bool
+	for _, rule := range rules {
+		if len(rule.Languages) > 0 && !matchLanguage(rule.Languages, lang) {
+			continue
+		}
+		if len(rule.Devices) > 0 && !contains(rule.Devices, device) {
+			continue
+		}
+		if len(rule.Countries) > 0 {
+			if !countryResolved {
+				country = resolveCountry(ctx, resolver, visitor.IP)
+				countryResolved = true
+			}
+			if !contains(rule.Countries, country) {
+				continue
+			}
+		}
+		return rule, true
+	}
+	return entity.RedirectRule{}, false
+}
+
+func preferredLanguage(acceptLanguage string) string {
+	tags, q, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 || q[0] <= 0 {
+		return ""
+	}
+	return tags[0].String()
+}
+
+func matchLanguage(languages []string, lang string) bool {
+	if lang == "" {
+		return false
+	}
+	lang = strings.ToLower(lang)
+	for _, l := range languages {
+		l = strings.ToLower(l)
+		if lang == l || strings.HasPrefix(lang, l+"-") {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveCountry(ctx context.Context, resolver CountryResolver, ip string) string {
+	if ip == "" {
+		return ""
+	}
+	country, err := resolver.Country(ctx, ip)
+	if err != nil {
+		log.Printf("resolver.Country: %v", err)
+		return ""
+	}
+	return country
+}
+
+func contains(values []string, v string) bool {
+	if v == "" {
+		return false
+	}
+	for _, value := range values {
+		if strings.EqualFold(value, v) {
+			return true
+		}
+	}
+	return false
+}
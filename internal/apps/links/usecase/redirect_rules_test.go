@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/stretchr/testify/require"
+)
+
+type countryResolverStub map[string]string
+
+func (s countryResolverStub) Country(_ context.Context, ip string) (string, error) {
+	return s[ip], nil
+}
+
+func TestMatchRedirectRule(t *testing.T) {
+	const (
+		iPhoneUA  = "Mozilla/5.0 (iPhone; CPU iPhone OS 17_4 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Mobile/15E148 Safari/604.1"
+		androidUA = "Mozilla/5.0 (Linux; Android 14; Pixel 8) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Mobile Safari/537.36"
+		desktopUA = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+	)
+
+	rules := []entity.RedirectRule{
+		{Devices: []string{"ios"}, Href: "https://apps.apple.com/app"},
+		{Devices: []string{"android"}, Href: "https://play.google.com/store/apps"},
+		{Languages: []string{"de"}, Countries: []string{"AT"}, Href: "https://example.com/at"},
+		{Languages: []string{"de"}, Href: "https://example.com/de"},
+	}
+	resolver := countryResolverStub{"203.0.113.7": "AT"}
+
+	tests := []struct {
+		name    string
+		visitor Visitor
+		href    string
+		ok      bool
+	}{
+		{name: "ios", visitor: Visitor{UserAgent: iPhoneUA}, href: "https://apps.apple.com/app", ok: true},
+		{name: "android", visitor: Visitor{UserAgent: androidUA}, href: "https://play.google.com/store/apps", ok: true},
+		{name: "language and country", visitor: Visitor{UserAgent: desktopUA, AcceptLanguage: "de-AT,de;q=0.9", IP: "203.0.113.7"}, href: "https://example.com/at", ok: true},
+		{name: "language only", visitor: Visitor{UserAgent: desktopUA, AcceptLanguage: "de-DE,en;q=0.5", IP: "198.51.100.1"}, href: "https://example.com/de", ok: true},
+		{name: "less preferred language", visitor: Visitor{UserAgent: desktopUA, AcceptLanguage: "fr,de;q=0.5"}, ok: false},
+		{name: "fallback", visitor: Visitor{UserAgent: desktopUA, AcceptLanguage: "en-US"}, ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			rule, ok := matchRedirectRule(context.Background(), resolver, rules, tt.visitor)
+
+			r.Equal(tt.ok, ok)
+			r.Equal(tt.href, rule.Href)
+		})
+	}
+}
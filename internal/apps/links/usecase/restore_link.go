@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type RestoreLinkData struct {
+	ShortID string `validate:"required,short_id"`
+}
+
+type RestoreLinkResult struct {
+	Link entity.Link
+}
+
+type IRestoreLinkHandler interface {
+	Handle(ctx context.Context, data RestoreLinkData) (RestoreLinkResult, error)
+}
+
+// RestoreLinkHandler undoes a delete within the retention window. Restoring a
+// link that is not deleted returns it unchanged.
+type RestoreLinkHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	recentWrites *usecase.RecentWrites
+	retention    time.Duration
+}
+
+type RestoreLinkParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	RecentWrites *usecase.RecentWrites
+	Retention    time.Duration
+}
+
+func NewRestoreLinkHandler(params RestoreLinkParams) IRestoreLinkHandler {
+	return &RestoreLinkHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		recentWrites: params.RecentWrites,
+		retention:    params.Retention,
+	}
+}
+
+func (h *RestoreLinkHandler) Handle(ctx context.Context, data RestoreLinkData) (RestoreLinkResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleEditor)
+	if err != nil {
+		return RestoreLinkResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return RestoreLinkResult{}, usecase.NewErrValidation("Invalid link format", err)
+	}
+
+	var link entity.Link
+	err = h.repoFactory.InTransaction(ctx, func(repo LinkRepo) error {
+		var txErr error
+		link, txErr = repo.GetLinkByShortIDForUpdate(ctx, WorkspaceShortID{
+			WorkspaceID: principal.WorkspaceID,
+			ShortID:     data.ShortID,
+		})
+		if txErr != nil {
+			return txErr
+		}
+		if !link.IsDeleted() {
+			return nil
+		}
+		if time.Since(link.DeletedAt) > h.retention {
+			return ErrRestoreExpired
+		}
+
+		quota, txErr := repo.GetLinkQuota(ctx, principal.WorkspaceID)
+		if txErr != nil {
+			return fmt.Errorf("repo.GetLinkQuota: %w", txErr)
+		}
+		if !quota.Allows(1) {
+			return ErrQuotaExceeded
+		}
+
+		deleted := link
+		link, txErr = repo.RestoreLink(ctx, link.ID)
+		if txErr != nil {
+			return fmt.Errorf("repo.RestoreLink: %w", txErr)
+		}
+
+		txErr = repo.CreateEvent(ctx, newLinkEvent(entity.EventLinkRestored, link))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateEvent: %w", txErr)
+		}
+
+		txErr = repo.CreateAuditEntry(ctx, newAuditEntry(ctx, entity.AuditActionRestore, &deleted, &link))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateAuditEntry: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return RestoreLinkResult{}, err
+	}
+	h.recentWrites.Add(link.ShortID)
+	return RestoreLinkResult{Link: link}, nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+type deletedLinkRepo struct {
+	LinkRepo
+	link   entity.Link
+	quota  entity.LinkQuota
+	events []string
+	audit  []CreateAuditEntryArgs
+}
+
+func (r *deletedLinkRepo) GetLinkByShortIDForUpdate(ctx context.Context, args WorkspaceShortID) (entity.Link, error) {
+	return r.link, nil
+}
+
+func (r *deletedLinkRepo) GetLinkQuota(ctx context.Context, workspaceID int64) (entity.LinkQuota, error) {
+	return r.quota, nil
+}
+
+func (r *deletedLinkRepo) RestoreLink(ctx context.Context, id int64) (entity.Link, error) {
+	r.link.DeletedAt = time.Time{}
+	return r.link, nil
+}
+
+func (r *deletedLinkRepo) CreateEvent(ctx context.Context, args CreateEventArgs) error {
+	r.events = append(r.events, args.Type)
+	return nil
+}
+
+func (r *deletedLinkRepo) CreateAuditEntry(ctx context.Context, args CreateAuditEntryArgs) error {
+	r.audit = append(r.audit, args)
+	return nil
+}
+
+func TestRestoreLink(t *testing.T) {
+	v := validator.New()
+	v.RegisterValidation("short_id", func(fl validator.FieldLevel) bool { return true })
+
+	tests := []struct {
+		deletedAgo time.Duration
+		quota      entity.LinkQuota
+		err        error
+		events     []string
+	}{
+		{deletedAgo: time.Hour, events: []string{entity.EventLinkRestored}},
+		{deletedAgo: 48 * time.Hour, err: ErrRestoreExpired},
+		{deletedAgo: time.Hour, quota: entity.LinkQuota{MaxLinks: 2, Links: 2}, err: ErrQuotaExceeded},
+		{deletedAgo: 0},
+	}
+	for _, tt := range tests {
+		repo := &deletedLinkRepo{link: entity.Link{ID: 1, ShortID: "abc"}, quota: tt.quota}
+		if tt.deletedAgo > 0 {
+			repo.link.DeletedAt = time.Now().Add(-tt.deletedAgo)
+		}
+		h := NewRestoreLinkHandler(RestoreLinkParams{
+			RepoFactory: readRepoFactory{repo: repo},
+			Validator:   v,
+			Retention:   24 * time.Hour,
+		})
+
+		ctx := usecase.WithPrincipal(context.Background(), usecase.Principal{
+			Actor:       usecase.Actor{Type: usecase.ActorUser, ID: "alice"},
+			WorkspaceID: usecase.DefaultWorkspaceID,
+			Role:        usecase.RoleEditor,
+		})
+		result, err := h.Handle(ctx, RestoreLinkData{ShortID: "abc"})
+		if tt.err != nil {
+			require.ErrorIs(t, err, tt.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.False(t, result.Link.IsDeleted())
+		require.Equal(t, tt.events, repo.events)
+		require.Len(t, repo.audit, len(tt.events))
+	}
+}
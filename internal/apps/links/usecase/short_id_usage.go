@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/shortid"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ShortIDCapacity interface {
+	Len() int
+	SetLinkCount(length int, count int64) bool
+	Stats() shortid.Stats
+}
+
+type IRefreshShortIDUsageHandler interface {
+	Handle(ctx context.Context) error
+}
+
+// RefreshShortIDUsageHandler counts the taken IDs of the generated length,
+// growing the length while the keyspace is too full.
+type RefreshShortIDUsageHandler struct {
+	repoFactory usecase.RepoFactory[LinkRepo]
+	capacity    ShortIDCapacity
+}
+
+type RefreshShortIDUsageParams struct {
+	RepoFactory usecase.RepoFactory[LinkRepo]
+	Capacity    ShortIDCapacity
+}
+
+func NewRefreshShortIDUsageHandler(params RefreshShortIDUsageParams) IRefreshShortIDUsageHandler {
+	return &RefreshShortIDUsageHandler{
+		repoFactory: params.RepoFactory,
+		capacity:    params.Capacity,
+	}
+}
+
+func (h *RefreshShortIDUsageHandler) Handle(ctx context.Context) error {
+	repo := h.repoFactory.GetReadRepo(ctx)
+	for {
+		length := h.capacity.Len()
+		count, err := repo.CountShortIDsByLength(ctx, length)
+		if err != nil {
+			return fmt.Errorf("repo.CountShortIDsByLength: %w", err)
+		}
+		if !h.capacity.SetLinkCount(length, count) {
+			return nil
+		}
+	}
+}
+
+type GetShortIDStatsResult = shortid.Stats
+
+type IGetShortIDStatsHandler interface {
+	Handle(ctx context.Context) (GetShortIDStatsResult, error)
+}
+
+type GetShortIDStatsHandler struct {
+	capacity ShortIDCapacity
+}
+
+type GetShortIDStatsParams struct {
+	Capacity ShortIDCapacity
+}
+
+func NewGetShortIDStatsHandler(params GetShortIDStatsParams) IGetShortIDStatsHandler {
+	return &GetShortIDStatsHandler{
+		capacity: params.Capacity,
+	}
+}
+
+func (h *GetShortIDStatsHandler) Handle(ctx context.Context) (GetShortIDStatsResult, error) {
+	if _, err := usecase.AuthorizeOperator(ctx); err != nil {
+		return GetShortIDStatsResult{}, err
+	}
+	return h.capacity.Stats(), nil
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/shortid"
+	"github.com/stretchr/testify/require"
+)
+
+type countingRepo struct {
+	LinkRepo
+	counts map[int]int64
+}
+
+func (r *countingRepo) CountShortIDsByLength(ctx context.Context, length int) (int64, error) {
+	return r.counts[length], nil
+}
+
+type readRepoFactory struct {
+	repo LinkRepo
+}
+
+func (f readRepoFactory) GetRepo() LinkRepo                        { return f.repo }
+func (f readRepoFactory) GetReadRepo(ctx context.Context) LinkRepo { return f.repo }
+func (f readRepoFactory) InTransaction(ctx context.Context, txFn func(r LinkRepo) error) error {
+	return txFn(f.repo)
+}
+
+func TestRefreshShortIDUsage(t *testing.T) {
+	r := require.New(t)
+
+	policy, err := shortid.NewPolicy(shortid.PolicyParams{Alphabet: "ab", Len: 2, MaxLen: 6, GrowAt: 0.5})
+	r.NoError(err)
+	repo := &countingRepo{counts: map[int]int64{2: 4, 3: 6, 4: 1}}
+
+	h := NewRefreshShortIDUsageHandler(RefreshShortIDUsageParams{
+		RepoFactory: readRepoFactory{repo: repo},
+		Capacity:    policy,
+	})
+	r.NoError(h.Handle(context.Background()))
+
+	stats := policy.Stats()
+	r.Equal(4, stats.Len)
+	r.Equal(int64(1), stats.Links)
+	r.Equal(1.0/16, stats.Utilization)
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import "strings"
+
+// normalizeTags trims tags and drops repeated ones, keeping the first
+// occurrence. Tags are otherwise free-form and compared as is.
+func normalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result
+}
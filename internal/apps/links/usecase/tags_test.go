@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	require.Nil(t, normalizeTags(nil))
+	require.Equal(t, []string{}, normalizeTags([]string{}))
+	require.Equal(t, []string{"promo", "Promo", ""}, normalizeTags([]string{" promo", "Promo", "promo ", "  "}))
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type UnlockLinkData struct {
+	ShortID   string `validate:"required,short_id"`
+	Password  string `validate:"required,max=72"`
+	ClientKey string
+}
+
+type UnlockLinkResult struct {
+	AccessToken string
+	ExpiresAt   time.Time
+}
+
+type IUnlockLinkHandler interface {
+	Handle(ctx context.Context, data UnlockLinkData) (UnlockLinkResult, error)
+}
+
+type UnlockLinkHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	accessSigner AccessSigner
+	throttler    Throttler
+	accessTTL    time.Duration
+}
+
+type UnlockLinkParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	AccessSigner AccessSigner
+	Throttler    Throttler
+	AccessTTL    time.Duration
+}
+
+func NewUnlockLinkHandler(params UnlockLinkParams) IUnlockLinkHandler {
+	return &UnlockLinkHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		accessSigner: params.AccessSigner,
+		throttler:    params.Throttler,
+		accessTTL:    params.AccessTTL,
+	}
+}
+
+func (h *UnlockLinkHandler) Handle(ctx context.Context, data UnlockLinkData) (UnlockLinkResult, error) {
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return UnlockLinkResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	throttleKey := data.ShortID + "|" + data.ClientKey
+	if !h.throttler.Allow(throttleKey) {
+		return UnlockLinkResult{}, usecase.ErrTooManyRequests
+	}
+
+	link, err := h.repoFactory.GetRepo().GetLinkByShortID(ctx, data.ShortID)
+	if err != nil {
+		return UnlockLinkResult{}, err
+	}
+	if link.IsDeleted() {
+		return UnlockLinkResult{}, ErrLinkDeleted
+	}
+	if !link.IsProtected() {
+		return UnlockLinkResult{}, nil
+	}
+
+	err = bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(data.Password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return UnlockLinkResult{}, ErrInvalidPassword
+	}
+	if err != nil {
+		return UnlockLinkResult{}, fmt.Errorf("bcrypt.CompareHashAndPassword: %w", err)
+	}
+	h.throttler.Reset(throttleKey)
+
+	return UnlockLinkResult{
+		AccessToken: h.accessSigner.Sign(accessTokenValue(link.ShortID), h.accessTTL),
+		ExpiresAt:   time.Now().Add(h.accessTTL),
+	}, nil
+}
+
+func accessTokenValue(shortID string) string {
+	return "link:" + shortID
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type UpdateLinkData struct {
+	ShortID        string              `validate:"required,short_id"`
+	Href           *string             `validate:"omitnil,http_url"`
+	Password       *string             `validate:"omitnil,max=72"`
+	MaxClicks      *int64              `validate:"omitnil,min=0"`
+	Rules          *[]RedirectRuleData `validate:"omitnil,max=20,dive"`
+	Variants       *[]VariantData      `validate:"omitnil,max=10,unique=Name,dive"`
+	StickyVariants *bool
+	QueryOptions   *QueryOptionsData
+	Title          *string            `validate:"omitnil,max=200"`
+	Description    *string            `validate:"omitnil,max=2000"`
+	Tags           *[]string          `validate:"omitnil,max=20,dive,min=1,max=64"`
+	Metadata       *map[string]string `validate:"omitnil,max=50,dive,keys,min=1,max=64,endkeys,max=1024"`
+}
+
+type UpdateLinkResult struct {
+	Link entity.Link
+}
+
+type IUpdateLinkHandler interface {
+	Handle(ctx context.Context, data UpdateLinkData) (UpdateLinkResult, error)
+}
+
+type UpdateLinkHandler struct {
+	repoFactory  usecase.RepoFactory[LinkRepo]
+	validator    *validator.Validate
+	recentWrites *usecase.RecentWrites
+}
+
+type UpdateLinkParams struct {
+	RepoFactory  usecase.RepoFactory[LinkRepo]
+	Validator    *validator.Validate
+	RecentWrites *usecase.RecentWrites
+}
+
+func NewUpdateLinkHandler(params UpdateLinkParams) IUpdateLinkHandler {
+	return &UpdateLinkHandler{
+		repoFactory:  params.RepoFactory,
+		validator:    params.Validator,
+		recentWrites: params.RecentWrites,
+	}
+}
+
+func (h *UpdateLinkHandler) Handle(ctx context.Context, data UpdateLinkData) (UpdateLinkResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleEditor)
+	if err != nil {
+		return UpdateLinkResult{}, err
+	}
+	if data.Tags != nil {
+		tags := normalizeTags(*data.Tags)
+		data.Tags = &tags
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return UpdateLinkResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+	if data.Password != nil && *data.Password != "" && len(*data.Password) < 4 {
+		return UpdateLinkResult{}, usecase.NewErrValidation("Invalid request", errors.New("password is too short"))
+	}
+	if data.Variants != nil && len(*data.Variants) == 1 {
+		return UpdateLinkResult{}, usecase.NewErrValidation("Invalid request", errors.New("at least two variants are required"))
+	}
+	if data.Rules != nil {
+		for _, rule := range *data.Rules {
+			if !rule.hasConditions() {
+				return UpdateLinkResult{}, usecase.NewErrValidation("Invalid request", errors.New("redirect rule without conditions"))
+			}
+		}
+	}
+
+	var passwordHash string
+	if data.Password != nil && *data.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(*data.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return UpdateLinkResult{}, fmt.Errorf("bcrypt.GenerateFromPassword: %w", err)
+		}
+		passwordHash = string(hash)
+	}
+
+	var link entity.Link
+	err = h.repoFactory.InTransaction(ctx, func(repo LinkRepo) error {
+		current, txErr := repo.GetLinkByShortIDForUpdate(ctx, WorkspaceShortID{
+			WorkspaceID: principal.WorkspaceID,
+			ShortID:     data.ShortID,
+		})
+		if txErr != nil {
+			return txErr
+		}
+		if current.IsDeleted() {
+			return ErrLinkDeleted
+		}
+
+		args := UpdateLinkArgs{
+			ID:             current.ID,
+			Href:           current.Href,
+			PasswordHash:   current.PasswordHash,
+			MaxClicks:      current.MaxClicks,
+			Rules:          current.Rules,
+			Variants:       current.Variants,
+			StickyVariants: current.StickyVariants,
+			QueryOptions:   current.QueryOptions,
+			Title:          current.Title,
+			Description:    current.Description,
+			Tags:           current.Tags,
+			Metadata:       current.Metadata,
+		}
+		if data.Href != nil {
+			args.Href = *data.Href
+		}
+		if data.Password != nil {
+			args.PasswordHash = passwordHash
+		}
+		if data.MaxClicks != nil {
+			args.MaxClicks = *data.MaxClicks
+		}
+		if data.Rules != nil {
+			args.Rules = toRedirectRules(*data.Rules)
+		}
+		if data.Variants != nil {
+			args.Variants = toVariants(*data.Variants)
+		}
+		if data.StickyVariants != nil {
+			args.StickyVariants = *data.StickyVariants
+		}
+		if data.QueryOptions != nil {
+			args.QueryOptions = entity.QueryOptions(*data.QueryOptions)
+		}
+		if data.Title != nil {
+			args.Title = *data.Title
+		}
+		if data.Description != nil {
+			args.Description = *data.Description
+		}
+		if data.Tags != nil {
+			args.Tags = *data.Tags
+		}
+		if data.Metadata != nil {
+			args.Metadata = *data.Metadata
+		}
+
+		link, txErr = repo.UpdateLink(ctx, args)
+		if txErr != nil {
+			return fmt.Errorf("repo.UpdateLink: %w", txErr)
+		}
+
+		txErr = repo.CreateEvent(ctx, newLinkEvent(entity.EventLinkUpdated, link))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateEvent: %w", txErr)
+		}
+
+		txErr = repo.CreateAuditEntry(ctx, newAuditEntry(ctx, entity.AuditActionUpdate, &current, &link))
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateAuditEntry: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return UpdateLinkResult{}, err
+	}
+	h.recentWrites.Add(link.ShortID)
+	return UpdateLinkResult{Link: link}, nil
+}
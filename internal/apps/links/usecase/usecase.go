@@ -2,19 +2,158 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
 )
 
 type CreateLinkArgs struct {
-	ShortID string
-	Href    string
+	WorkspaceID    int64
+	ShortID        string
+	Href           string
+	PasswordHash   string
+	Reusable       bool
+	MaxClicks      int64
+	Rules          []entity.RedirectRule
+	Variants       []entity.Variant
+	StickyVariants bool
+	QueryOptions   entity.QueryOptions
+	Title          string
+	Description    string
+	Tags           []string
+	Metadata       map[string]string
+}
+
+type GetReusableLinkByHrefArgs struct {
+	WorkspaceID int64
+	Href        string
+}
+
+// WorkspaceShortID finds a short ID only within the workspace, so that those
+// of other workspaces look unused.
+type WorkspaceShortID struct {
+	WorkspaceID int64
+	ShortID     string
+}
+
+type UpdateLinkArgs struct {
+	ID             int64
+	Href           string
+	PasswordHash   string
+	MaxClicks      int64
+	Rules          []entity.RedirectRule
+	Variants       []entity.Variant
+	StickyVariants bool
+	QueryOptions   entity.QueryOptions
+	Title          string
+	Description    string
+	Tags           []string
+	Metadata       map[string]string
+}
+
+// ListLinksArgs matches links having all Tags and all Metadata pairs.
+type ListLinksArgs struct {
+	WorkspaceID int64
+	Tags        []string
+	Metadata    map[string]string
+	BeforeID    int64
+	Limit       int32
+}
+
+type ImportLinkArgs struct {
+	WorkspaceID int64
+	ShortID     string
+	Href        string
+}
+
+type CreateEventArgs struct {
+	WorkspaceID int64
+	Type        string
+	LinkID      int64
+	Payload     any
+}
+
+type CreateLinkClickArgs struct {
+	WorkspaceID int64
+	LinkID      int64
+	Variant     string
+}
+
+type CreateAuditEntryArgs struct {
+	WorkspaceID int64
+	LinkID      int64
+	ShortID     string
+	Action      string
+	Actor       usecase.Actor
+	OldValue    any
+	NewValue    any
+}
+
+type ListAuditEntriesArgs struct {
+	WorkspaceID int64
+	ShortID     string
+	Action      string
+	ActorType   string
+	ActorID     string
+	Since       time.Time
+	Until       time.Time
+	BeforeID    int64
+	Limit       int32
+}
+
+// PurgeDeletedLinksArgs attributes the purge of each link to Actor.
+type PurgeDeletedLinksArgs struct {
+	Before time.Time
+	Limit  int
+	Actor  usecase.Actor
 }
 
 type LinkRepo interface {
 	CreateLink(context.Context, CreateLinkArgs) (entity.Link, error)
-	GetLinkByHref(context.Context, string) (entity.Link, error)
-	IsLinkExistByShortID(context.Context, string) (bool, error)
+	GetReusableLinkByHref(context.Context, GetReusableLinkByHrefArgs) (entity.Link, error)
+	IsShortIDTaken(context.Context, string) (bool, error)
+	IsShortIDInWorkspace(context.Context, WorkspaceShortID) (bool, error)
+	ListTakenShortIDs(context.Context, []string) ([]string, error)
 	GetLinkByShortID(context.Context, string) (entity.Link, error)
-	UpdateLinkUsageInfo(context.Context, int64) error
+	GetWorkspaceLinkByShortID(context.Context, WorkspaceShortID) (entity.Link, error)
+	GetLinkByShortIDForUpdate(context.Context, WorkspaceShortID) (entity.Link, error)
+	GetLinkQuota(context.Context, int64) (entity.LinkQuota, error)
+	GetWorkspaceIDByDomain(context.Context, string) (int64, error)
+	UpdateLink(context.Context, UpdateLinkArgs) (entity.Link, error)
+	SoftDeleteLink(context.Context, int64) (entity.Link, error)
+	RestoreLink(context.Context, int64) (entity.Link, error)
+	PurgeDeletedLinks(context.Context, PurgeDeletedLinksArgs) (int64, error)
+	UpdateLinkUsageInfo(context.Context, int64) (int64, error)
+	CreateLinkClick(context.Context, CreateLinkClickArgs) error
+	CountLinkClicksByVariant(context.Context, int64) ([]entity.VariantStats, error)
+	CreateEvent(context.Context, CreateEventArgs) error
+	CreateEvents(context.Context, []CreateEventArgs) error
+	ImportLinks(context.Context, []ImportLinkArgs) (int64, error)
+	ListLinksByShortIDs(context.Context, []string) ([]entity.Link, error)
+	ListLinks(context.Context, ListLinksArgs) ([]entity.Link, error)
+	ListTags(context.Context, int64) ([]entity.TagCount, error)
+	CountShortIDsByLength(context.Context, int) (int64, error)
+	CreateAuditEntry(context.Context, CreateAuditEntryArgs) error
+	CreateAuditEntries(context.Context, []CreateAuditEntryArgs) error
+	ListAuditEntries(context.Context, ListAuditEntriesArgs) ([]entity.AuditEntry, error)
+}
+
+type AccessSigner interface {
+	Sign(value string, ttl time.Duration) string
+	Verify(token string, value string) bool
+}
+
+type CountryResolver interface {
+	Country(ctx context.Context, ip string) (string, error)
+}
+
+type ShortIDGenerator interface {
+	Generate() (string, error)
+	Observe(taken bool)
+}
+
+type Throttler interface {
+	Allow(key string) bool
+	Reset(key string)
 }
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+type VariantData struct {
+	Name   string `validate:"required,alphanum,max=32"`
+	Href   string `validate:"required,http_url"`
+	Weight int    `validate:"min=1,max=1000"`
+}
+
+func toVariants(data []VariantData) []entity.Variant {
+	variants := make([]entity.Variant, 0, len(data))
+	for _, d := range data {
+		variants = append(variants, entity.Variant(d))
+	}
+	return variants
+}
+
+// pickVariant keeps the visitor's previous variant while it still exists
+// with a weight, otherwise picks one by weight with intn, a rand.Intn.
+func pickVariant(variants []entity.Variant, previous string, intn func(n int) int) entity.Variant {
+	total := 0
+	for _, v := range variants {
+		if previous != "" && v.Name == previous && v.Weight > 0 {
+			return v
+		}
+		total += v.Weight
+	}
+	if total <= 0 {
+		return variants[0]
+	}
+
+	n := intn(total)
+	for _, v := range variants {
+		if n < v.Weight {
+			return v
+		}
+		n -= v.Weight
+	}
+	return variants[len(variants)-1]
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickVariantWeights(t *testing.T) {
+	variants := []entity.Variant{
+		{Name: "a", Weight: 1},
+		{Name: "b", Weight: 3},
+		{Name: "off", Weight: 0},
+		{Name: "c", Weight: 6},
+	}
+
+	// Every value intn can return picks one variant, so each is picked as
+	// many times as its weight.
+	picked := map[string]int{}
+	for n := 0; n < 10; n++ {
+		v := pickVariant(variants, "", func(total int) int {
+			require.Equal(t, 10, total)
+			return n
+		})
+		picked[v.Name]++
+	}
+	require.Equal(t, map[string]int{"a": 1, "b": 3, "c": 6}, picked)
+
+	picked = map[string]int{}
+	intn := rand.New(rand.NewSource(1)).Intn
+	for i := 0; i < 10000; i++ {
+		picked[pickVariant(variants, "", intn).Name]++
+	}
+	require.InDelta(t, 1000, picked["a"], 150)
+	require.InDelta(t, 3000, picked["b"], 250)
+	require.InDelta(t, 6000, picked["c"], 250)
+	require.Zero(t, picked["off"])
+}
+
+func TestPickVariantSticky(t *testing.T) {
+	variants := []entity.Variant{
+		{Name: "a", Weight: 1},
+		{Name: "b", Weight: 1},
+		{Name: "off", Weight: 0},
+	}
+	first := func(int) int { return 0 }
+
+	tests := []struct {
+		name     string
+		previous string
+		exp      string
+	}{
+		{name: "previous variant kept", previous: "b", exp: "b"},
+		{name: "no previous variant", previous: "", exp: "a"},
+		{name: "removed previous variant", previous: "gone", exp: "a"},
+		{name: "zero-weight previous variant", previous: "off", exp: "a"},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.exp, pickVariant(variants, tt.previous, first).Name, tt.name)
+	}
+}
@@ -0,0 +1,133 @@
+"use strict";
+
+// Renders /openapi.json without third-party code: operations grouped by tag,
+// then the component schemas. Text from the document is only ever set with
+// textContent.
+
+function element(tag, text, className) {
+  const node = document.createElement(tag);
+  if (text !== undefined) node.textContent = text;
+  if (className) node.className = className;
+  return node;
+}
+
+function schemaName(ref) {
+  return ref.replace("#/components/schemas/", "");
+}
+
+// schemaNode shows a schema as a link when it is a reference, else as JSON.
+function schemaNode(schema) {
+  if (schema && schema.$ref) {
+    const link = element("a", schemaName(schema.$ref));
+    link.href = "#schema-" + schemaName(schema.$ref);
+    return link;
+  }
+  return element("pre", JSON.stringify(schema, null, 2));
+}
+
+function resolve(doc, item) {
+  if (!item || !item.$ref) return item;
+  const path = item.$ref.replace("#/", "").split("/");
+  return path.reduce((node, key) => node && node[key], doc);
+}
+
+function contentList(content) {
+  const list = element("ul");
+  for (const [type, media] of Object.entries(content || {})) {
+    const item = element("li", type + " ");
+    if (media.schema) item.append(schemaNode(media.schema));
+    list.append(item);
+  }
+  return list;
+}
+
+function parametersTable(doc, parameters) {
+  const table = element("table");
+  const head = element("tr");
+  for (const title of ["Name", "In", "Required", "Description"]) head.append(element("th", title));
+  table.append(head);
+  for (const parameter of parameters.map((p) => resolve(doc, p))) {
+    const row = element("tr");
+    row.append(
+      element("td", parameter.name, "path"),
+      element("td", parameter.in),
+      element("td", parameter.required ? "yes" : "no"),
+      element("td", parameter.description || ""),
+    );
+    table.append(row);
+  }
+  return table;
+}
+
+function operationNode(doc, path, method, operation) {
+  const details = element("details");
+  const summary = element("summary");
+  summary.append(
+    element("span", method, "method"),
+    element("span", path, "path"),
+    " ",
+    element("span", operation.summary || "", "muted"),
+  );
+  details.append(summary);
+  if (operation.description) details.append(element("p", operation.description));
+
+  const parameters = [...(doc.paths[path].parameters || []), ...(operation.parameters || [])];
+  if (parameters.length > 0) {
+    details.append(element("h3", "Parameters"), parametersTable(doc, parameters));
+  }
+  const body = resolve(doc, operation.requestBody);
+  if (body) {
+    details.append(element("h3", body.required ? "Request body (required)" : "Request body"), contentList(body.content));
+  }
+  details.append(element("h3", "Responses"));
+  const responses = element("ul");
+  for (const [status, value] of Object.entries(operation.responses || {})) {
+    const response = resolve(doc, value) || {};
+    const item = element("li", status + " " + (response.description || ""));
+    if (response.content) item.append(contentList(response.content));
+    responses.append(item);
+  }
+  details.append(responses);
+  return details;
+}
+
+function render(doc) {
+  document.title = doc.info.title;
+  document.getElementById("title").textContent = doc.info.title + " " + doc.info.version;
+  document.getElementById("description").textContent = doc.info.description || "";
+
+  const groups = new Map();
+  for (const [path, item] of Object.entries(doc.paths)) {
+    for (const [method, operation] of Object.entries(item)) {
+      if (method === "parameters") continue;
+      const tag = (operation.tags && operation.tags[0]) || "other";
+      if (!groups.has(tag)) groups.set(tag, []);
+      groups.get(tag).push(operationNode(doc, path, method, operation));
+    }
+  }
+  const operations = document.getElementById("operations");
+  for (const [tag, nodes] of groups) {
+    operations.append(element("h2", tag), ...nodes);
+  }
+
+  const schemas = document.getElementById("schemas");
+  for (const [name, schema] of Object.entries(doc.components.schemas || {})) {
+    const details = element("details");
+    details.id = "schema-" + name;
+    details.append(element("summary", name, "path"), element("pre", JSON.stringify(schema, null, 2)));
+    schemas.append(details);
+  }
+}
+
+// A link to a schema opens it.
+window.addEventListener("hashchange", () => {
+  const target = document.getElementById(location.hash.slice(1));
+  if (target && target.tagName === "DETAILS") target.open = true;
+});
+
+fetch("/openapi.json")
+  .then((response) => response.json())
+  .then(render)
+  .catch((error) => {
+    document.getElementById("operations").append(element("p", "Could not load /openapi.json: " + error.message));
+  });
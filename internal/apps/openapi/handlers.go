@@ -0,0 +1,37 @@
+package openapi
+
+import "net/http"
+
+type SpecHandler struct{}
+
+func NewSpecHandler() *SpecHandler {
+	return new(SpecHandler)
+}
+
+func (h *SpecHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(spec)
+}
+
+type DocsHandler struct{}
+
+func NewDocsHandler() *DocsHandler {
+	return new(DocsHandler)
+}
+
+func (h *DocsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(docsPage)
+}
+
+type DocsAssetHandler struct{}
+
+func NewDocsAssetHandler() *DocsAssetHandler {
+	return new(DocsAssetHandler)
+}
+
+func (h *DocsAssetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, docsAssets, r.PathValue("file"))
+}
@@ -0,0 +1,50 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocsHandlers(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /docs", NewDocsHandler())
+	mux.Handle("GET /docs/{file}", NewDocsAssetHandler())
+
+	tests := []struct {
+		path        string
+		status      int
+		contentType string
+	}{
+		{path: "/docs", status: http.StatusOK, contentType: "text/html"},
+		{path: "/docs/docs.js", status: http.StatusOK, contentType: "text/javascript"},
+		{path: "/docs/docs.css", status: http.StatusOK, contentType: "text/css"},
+		{path: "/docs/openapi.json", status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		require.Equal(t, tt.status, w.Code, tt.path)
+		if tt.contentType != "" {
+			require.True(t, strings.HasPrefix(w.Header().Get("content-type"), tt.contentType), tt.path)
+		}
+	}
+}
+
+// TestDocsPageAssets checks that the page only loads embedded assets, never
+// scripts or styles from another origin.
+func TestDocsPageAssets(t *testing.T) {
+	refs := regexp.MustCompile(`(?:src|href)="([^"]+)"`).FindAllStringSubmatch(string(docsPage), -1)
+	require.NotEmpty(t, refs)
+	for _, ref := range refs {
+		require.True(t, strings.HasPrefix(ref[1], "/"), ref[1])
+		if name, ok := strings.CutPrefix(ref[1], "/docs/"); ok {
+			_, err := docsAssets.Open(name)
+			require.NoError(t, err, name)
+		}
+	}
+}
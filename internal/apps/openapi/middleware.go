@@ -0,0 +1,70 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+// ValidateRequests checks JSON request bodies against the schema documented
+// for the route matched by mux before passing the request on to it.
+func ValidateRequests(doc Document, mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		_, pattern := mux.Handler(r)
+		schema, ok := doc.requestSchema(pattern)
+		mediaType, _, _ := mime.ParseMediaType(r.Header.Get("content-type"))
+		if !ok || mediaType != "application/json" {
+			mux.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := httpx.ReadBody(r)
+		if err != nil {
+			httpx.HandleError(ctx, w, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			httpx.HandleError(ctx, w, errors.Join(httpx.ErrJsonUnmarshal, err))
+			return
+		}
+		if err := doc.Validate(schema, value); err != nil {
+			httpx.HandleError(ctx, w, usecase.NewErrValidation("Invalid request", err))
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (d Document) requestSchema(pattern string) (Schema, bool) {
+	method, path, ok := strings.Cut(pattern, " ")
+	if !ok {
+		return Schema{}, false
+	}
+	op, ok := d.Paths[SpecPath(path)][strings.ToLower(method)]
+	if !ok || op.RequestBody == nil {
+		return Schema{}, false
+	}
+	content, ok := op.RequestBody.Content["application/json"]
+	if !ok {
+		return Schema{}, false
+	}
+	return content.Schema, true
+}
+
+// SpecPath converts a ServeMux path pattern to an OpenAPI path.
+func SpecPath(path string) string {
+	return strings.ReplaceAll(path, "...}", "}")
+}
@@ -0,0 +1,47 @@
+package openapi
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+)
+
+//go:embed openapi.json
+var spec []byte
+
+// The docs page renders the document itself instead of loading a viewer
+// from a CDN, so no third-party script runs in the origin of /ui.
+//
+//go:embed docs.html
+var docsPage []byte
+
+//go:embed docs.js docs.css
+var docsAssets embed.FS
+
+type Document struct {
+	Paths      map[string]map[string]Operation `json:"paths"`
+	Components struct {
+		Schemas map[string]Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type Operation struct {
+	OperationID string `json:"operationId"`
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema Schema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+}
+
+func Spec() []byte {
+	return spec
+}
+
+func Load() (Document, error) {
+	var doc Document
+	if err := json.Unmarshal(spec, &doc); err != nil {
+		return Document{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return doc, nil
+}
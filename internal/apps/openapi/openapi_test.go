@@ -0,0 +1,274 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	links_http "github.com/kirillismad/go-url-shortener/internal/apps/links/http"
+	links_usecase "github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	webhooks_http "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/http"
+	workspaces_http "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/http"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/health"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+var schemaTypes = map[string]any{
+	"RedirectRule":                  links_http.RedirectRuleInput{},
+	"Variant":                       links_http.VariantInput{},
+	"QueryOptions":                  links_http.QueryOptionsInput{},
+	"CreateLinkInput":               links_http.CreateLinkInput{},
+	"CreateLinkOutput":              links_http.CreateLinkOutput{},
+	"UpdateLinkInput":               links_http.UpdateLinkInput{},
+	"ImportLinkInput":               links_http.ImportLinkInput{},
+	"ImportLinksInput":              links_http.ImportLinksInput{},
+	"ImportedLinkOutput":            links_http.ImportedLinkOutput{},
+	"ImportLinksOutput":             links_http.ImportLinksOutput{},
+	"LinkOutput":                    links_http.LinkOutput{},
+	"VariantStatsOutput":            links_http.VariantStatsOutput{},
+	"GetLinkStatsOutput":            links_http.GetLinkStatsOutput{},
+	"ShortIDStatsOutput":            links_http.ShortIDStatsOutput{},
+	"ListLinksOutput":               links_http.ListLinksOutput{},
+	"TagCountOutput":                links_http.TagCountOutput{},
+	"ListTagsOutput":                links_http.ListTagsOutput{},
+	"AuditActorOutput":              links_http.AuditActorOutput{},
+	"AuditEntryOutput":              links_http.AuditEntryOutput{},
+	"AuditEntriesOutput":            links_http.AuditEntriesOutput{},
+	"RegisterWebhookInput":          webhooks_http.RegisterWebhookInput{},
+	"WebhookOutput":                 webhooks_http.WebhookOutput{},
+	"RegisterWebhookOutput":         webhooks_http.RegisterWebhookOutput{},
+	"ListWebhooksOutput":            webhooks_http.ListWebhooksOutput{},
+	"DeliveryOutput":                webhooks_http.DeliveryOutput{},
+	"ListWebhookDeliveriesOutput":   webhooks_http.ListWebhookDeliveriesOutput{},
+	"ReplayWebhookDeliveriesOutput": webhooks_http.ReplayWebhookDeliveriesOutput{},
+	"WorkspaceOutput":               workspaces_http.WorkspaceOutput{},
+	"CreateWorkspaceInput":          workspaces_http.CreateWorkspaceInput{},
+	"CreateWorkspaceOutput":         workspaces_http.CreateWorkspaceOutput{},
+	"ListWorkspacesOutput":          workspaces_http.ListWorkspacesOutput{},
+	"UpdateWorkspaceInput":          workspaces_http.UpdateWorkspaceInput{},
+	"GetWorkspaceOutput":            workspaces_http.GetWorkspaceOutput{},
+	"DomainOutput":                  workspaces_http.DomainOutput{},
+	"MemberOutput":                  workspaces_http.MemberOutput{},
+	"SetMemberInput":                workspaces_http.SetMemberInput{},
+	"ListMembersOutput":             workspaces_http.ListMembersOutput{},
+	"APIKeyOutput":                  workspaces_http.APIKeyOutput{},
+	"CreatedAPIKeyOutput":           workspaces_http.CreatedAPIKeyOutput{},
+	"CreateAPIKeyInput":             workspaces_http.CreateAPIKeyInput{},
+	"ListAPIKeysOutput":             workspaces_http.ListAPIKeysOutput{},
+	"Problem":                       httpx.Problem{},
+	"FieldError":                    httpx.FieldError{},
+	"HealthReport":                  health.Report{},
+	"HealthCheck":                   health.CheckResult{},
+}
+
+// Schemas that are not backed by a Go struct: the ping body is an httpx.J
+// map and the unlock form is read with PostFormValue.
+var untypedSchemas = []string{"Pong", "UnlockLinkForm"}
+
+func TestSchemasMatchTypes(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+
+	for name := range doc.Components.Schemas {
+		_, typed := schemaTypes[name]
+		require.True(t, typed || slices.Contains(untypedSchemas, name), "schema %s is not checked", name)
+	}
+	for name, value := range schemaTypes {
+		schema, ok := doc.Components.Schemas[name]
+		require.True(t, ok, "schema %s is missing", name)
+		require.NoError(t, doc.compare(schema, reflect.TypeOf(value), false), name)
+	}
+}
+
+func TestErrorsMatchSchema(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+
+	errs := []error{
+		usecase.NewErrValidation("Invalid request", errors.New("bad")),
+		usecase.NewErrValidation("Invalid request", &ValidationError{Path: "/rules/0/href", Keyword: "type", Message: "expected string"}),
+		usecase.NewError(usecase.ErrGone, "link_exhausted", "link click limit reached"),
+		httpx.ErrJsonUnmarshal,
+		httpx.ErrCrossOriginForm,
+		usecase.ErrNoResult,
+		usecase.ErrGone,
+		usecase.ErrUnauthorized,
+		usecase.ErrForbidden,
+		usecase.ErrConflict,
+		usecase.ErrTooManyRequests,
+		usecase.ErrUnavailable,
+		links_usecase.ErrShortIDsExhausted,
+		errors.New("boom"),
+	}
+	for _, e := range errs {
+		w := httptest.NewRecorder()
+		httpx.HandleError(context.Background(), w, e)
+
+		require.Equal(t, httpx.ProblemContentType, w.Header().Get("content-type"))
+		var body any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		require.NoError(t, doc.Validate(Schema{Ref: "#/components/schemas/Problem"}, body), e.Error())
+	}
+}
+
+func TestValidateRequests(t *testing.T) {
+	doc, err := Load()
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /new", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	})
+	handler := ValidateRequests(doc, mux)
+
+	tests := []struct {
+		body   string
+		status int
+	}{
+		{body: `{"href": "https://example.com"}`, status: http.StatusCreated},
+		{body: `{"href": "https://example.com", "maxClicks": 1, "rules": [{"devices": ["ios"], "href": "https://apps.apple.com"}]}`, status: http.StatusCreated},
+		{body: `{}`, status: http.StatusBadRequest},
+		{body: `{"href": 1}`, status: http.StatusBadRequest},
+		{body: `{"href": "https://example.com", "maxClicks": 1.5}`, status: http.StatusBadRequest},
+		{body: `{"href": "https://example.com", "rules": [{"devices": ["tv"], "href": "https://example.com"}]}`, status: http.StatusBadRequest},
+		{body: `{`, status: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/new", strings.NewReader(tt.body))
+		r.Header.Set("content-type", "application/json")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		require.Equal(t, tt.status, w.Code, tt.body)
+	}
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// compare reports differences between a schema and the JSON encoding of t.
+func (d Document) compare(s Schema, t reflect.Type, nullable bool) error {
+	s, err := d.resolve(s)
+	if err != nil {
+		return err
+	}
+	if t == rawMessageType {
+		// Any JSON value fits, the schema only documents it.
+		return nil
+	}
+	if len(s.AnyOf) > 0 {
+		types := make([]string, 0, len(s.AnyOf))
+		for _, option := range s.AnyOf {
+			if slices.Equal(option.Type, SchemaType{"null"}) {
+				types = append(types, "null")
+				continue
+			}
+			if err := d.compare(option, t, false); err != nil {
+				return err
+			}
+		}
+		if nullable && !slices.Contains(types, "null") {
+			return fmt.Errorf("%s: expected nullable schema", t)
+		}
+		return nil
+	}
+
+	if t.Kind() == reflect.Pointer {
+		return d.compare(s, t.Elem(), nullable)
+	}
+	if nullable && !slices.Contains(s.Type, "null") {
+		return fmt.Errorf("%s: expected nullable schema", t)
+	}
+
+	expected := map[reflect.Kind]string{
+		reflect.String:  "string",
+		reflect.Bool:    "boolean",
+		reflect.Int:     "integer",
+		reflect.Int32:   "integer",
+		reflect.Int64:   "integer",
+		reflect.Float64: "number",
+		reflect.Slice:   "array",
+		reflect.Struct:  "object",
+		reflect.Map:     "object",
+	}[t.Kind()]
+	if t == timeType {
+		expected = "string"
+	}
+	if !slices.Contains(s.Type, expected) {
+		return fmt.Errorf("%s: schema type %v, expected %s", t, s.Type, expected)
+	}
+
+	switch {
+	case t.Kind() == reflect.Slice:
+		return d.compare(*s.Items, t.Elem(), false)
+	case t.Kind() == reflect.Map:
+		if s.AdditionalProperties == nil || s.AdditionalProperties.Schema == nil {
+			return fmt.Errorf("%s: expected additionalProperties schema", t)
+		}
+		return d.compare(*s.AdditionalProperties.Schema, t.Elem(), false)
+	case t.Kind() == reflect.Struct && t != timeType:
+		fields := jsonFields(t)
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		props := make([]string, 0, len(s.Properties))
+		for name := range s.Properties {
+			props = append(props, name)
+		}
+		sort.Strings(names)
+		sort.Strings(props)
+		if !slices.Equal(names, props) {
+			return fmt.Errorf("%s: fields %v, schema properties %v", t, names, props)
+		}
+		for name, field := range fields {
+			nullable := (field.Type.Kind() == reflect.Pointer || field.Type.Kind() == reflect.Slice || field.Type.Kind() == reflect.Map) && !field.omitempty
+			if (field.Type.Kind() == reflect.Slice || field.Type.Kind() == reflect.Map) && slices.Contains(s.Required, name) {
+				nullable = false
+			}
+			if err := d.compare(s.Properties[name], field.Type, nullable); err != nil {
+				return fmt.Errorf("%s.%s: %w", t, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+type jsonField struct {
+	Type      reflect.Type
+	omitempty bool
+}
+
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := make(map[string]jsonField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			for name, field := range jsonFields(f.Type) {
+				fields[name] = field
+			}
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" || !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = jsonField{Type: f.Type, omitempty: strings.Contains(opts, "omitempty")}
+	}
+	return fields
+}
@@ -0,0 +1,199 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+// Schema is the subset of JSON Schema used by openapi.json.
+type Schema struct {
+	Ref                  string            `json:"$ref"`
+	Type                 SchemaType        `json:"type"`
+	Properties           map[string]Schema `json:"properties"`
+	Required             []string          `json:"required"`
+	AdditionalProperties *Additional       `json:"additionalProperties"`
+	Items                *Schema           `json:"items"`
+	AnyOf                []Schema          `json:"anyOf"`
+	Enum                 []any             `json:"enum"`
+	Minimum              *float64          `json:"minimum"`
+	Maximum              *float64          `json:"maximum"`
+	MaxLength            *int              `json:"maxLength"`
+	MaxItems             *int              `json:"maxItems"`
+}
+
+// SchemaType accepts both "string" and ["string", "null"].
+type SchemaType []string
+
+func (t *SchemaType) UnmarshalJSON(b []byte) error {
+	var one string
+	if err := json.Unmarshal(b, &one); err == nil {
+		*t = SchemaType{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(b, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// Additional accepts both false and a schema for the values of properties
+// that are not listed.
+type Additional struct {
+	Forbidden bool
+	Schema    *Schema
+}
+
+func (a *Additional) UnmarshalJSON(b []byte) error {
+	var allowed bool
+	if err := json.Unmarshal(b, &allowed); err == nil {
+		a.Forbidden = !allowed
+		return nil
+	}
+	a.Schema = &Schema{}
+	return json.Unmarshal(b, a.Schema)
+}
+
+var indexPattern = regexp.MustCompile(`/(\d+)`)
+
+type ValidationError struct {
+	Path    string
+	Keyword string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+func (e *ValidationError) FieldErrors() []httpx.FieldError {
+	field := strings.TrimPrefix(e.Path, "/")
+	field = indexPattern.ReplaceAllString(field, "[$1]")
+	field = strings.ReplaceAll(field, "/", ".")
+	return []httpx.FieldError{{Field: field, Code: e.Keyword, Detail: e.Message}}
+}
+
+func (d Document) resolve(s Schema) (Schema, error) {
+	if s.Ref == "" {
+		return s, nil
+	}
+	name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/")
+	if !ok {
+		return Schema{}, fmt.Errorf("unsupported $ref %q", s.Ref)
+	}
+	resolved, ok := d.Components.Schemas[name]
+	if !ok {
+		return Schema{}, fmt.Errorf("unknown schema %q", name)
+	}
+	return resolved, nil
+}
+
+// Validate checks a value decoded by encoding/json against the schema.
+func (d Document) Validate(s Schema, value any) error {
+	return d.validate(s, value, "")
+}
+
+func (d Document) validate(s Schema, value any, path string) error {
+	s, err := d.resolve(s)
+	if err != nil {
+		return err
+	}
+
+	if len(s.AnyOf) > 0 {
+		for _, option := range s.AnyOf {
+			if d.validate(option, value, path) == nil {
+				return nil
+			}
+		}
+		return &ValidationError{Path: path, Keyword: "anyOf", Message: "does not match any allowed schema"}
+	}
+
+	if len(s.Type) > 0 && !slices.Contains(s.Type, typeOf(value)) && !(typeOf(value) == "integer" && slices.Contains(s.Type, "number")) {
+		return &ValidationError{Path: path, Keyword: "type", Message: fmt.Sprintf("expected %s", strings.Join(s.Type, " or "))}
+	}
+	if len(s.Enum) > 0 && !slices.Contains(s.Enum, value) {
+		return &ValidationError{Path: path, Keyword: "enum", Message: "value is not allowed"}
+	}
+
+	switch v := value.(type) {
+	case string:
+		if s.MaxLength != nil && len([]rune(v)) > *s.MaxLength {
+			return &ValidationError{Path: path, Keyword: "maxLength", Message: fmt.Sprintf("must be at most %d characters", *s.MaxLength)}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			return &ValidationError{Path: path, Keyword: "minimum", Message: fmt.Sprintf("must be >= %v", *s.Minimum)}
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			return &ValidationError{Path: path, Keyword: "maximum", Message: fmt.Sprintf("must be <= %v", *s.Maximum)}
+		}
+	case []any:
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			return &ValidationError{Path: path, Keyword: "maxItems", Message: fmt.Sprintf("must have at most %d items", *s.MaxItems)}
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				if err := d.validate(*s.Items, item, fmt.Sprintf("%s/%d", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				return &ValidationError{Path: path + "/" + name, Keyword: "required", Message: "is required"}
+			}
+		}
+		for name, field := range v {
+			prop, ok := s.Properties[name]
+			if !ok {
+				switch {
+				case s.AdditionalProperties == nil:
+					continue
+				case s.AdditionalProperties.Forbidden:
+					return &ValidationError{Path: path + "/" + name, Keyword: "additionalProperties", Message: "unknown field"}
+				case s.AdditionalProperties.Schema != nil:
+					prop = *s.AdditionalProperties.Schema
+				default:
+					continue
+				}
+			}
+			if err := d.validate(prop, field, path+"/"+name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func typeOf(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,218 @@
+"use strict";
+
+// The UI is a client of the JSON API: POST /new, GET /links,
+// PATCH /links/{short_id} and DELETE /links/{short_id}. Requests carry the
+// browser's cookies and proxy headers, plus the API key and workspace saved
+// in Settings, if any.
+
+const settings = {
+  get apiKey() { return localStorage.getItem("apiKey") || ""; },
+  get workspace() { return localStorage.getItem("workspace") || ""; },
+  save(apiKey, workspace) {
+    localStorage.setItem("apiKey", apiKey);
+    localStorage.setItem("workspace", workspace);
+  },
+};
+
+async function api(method, path, body) {
+  const headers = { Accept: "application/json" };
+  if (settings.apiKey) headers["X-API-Key"] = settings.apiKey;
+  if (settings.workspace) headers["X-Workspace"] = settings.workspace;
+  if (body !== undefined) headers["Content-Type"] = "application/json";
+
+  const response = await fetch(path, {
+    method,
+    headers,
+    credentials: "same-origin",
+    body: body === undefined ? undefined : JSON.stringify(body),
+  });
+  if (response.status === 204) return null;
+  const data = await response.json().catch(() => null);
+  if (!response.ok) {
+    throw new Error((data && (data.detail || data.title)) || response.statusText);
+  }
+  return data;
+}
+
+function absolute(shortLink) {
+  return new URL(shortLink, location.origin).href;
+}
+
+function parseTags(value) {
+  return value.split(",").map((t) => t.trim()).filter((t) => t !== "");
+}
+
+let messageTimer;
+function show(text, isError) {
+  const el = document.getElementById("message");
+  el.textContent = text;
+  el.classList.toggle("error", Boolean(isError));
+  el.hidden = false;
+  clearTimeout(messageTimer);
+  messageTimer = setTimeout(() => { el.hidden = true; }, 4000);
+}
+
+async function copy(text) {
+  try {
+    await navigator.clipboard.writeText(text);
+    show("Copied " + text);
+  } catch {
+    window.prompt("Copy the short link:", text);
+  }
+}
+
+// Links
+
+const list = {
+  tag: "",
+  nextBefore: null,
+};
+
+async function loadLinks(append) {
+  const params = new URLSearchParams({ limit: "50" });
+  if (list.tag) params.append("tag", list.tag);
+  if (append && list.nextBefore !== null) params.set("before", String(list.nextBefore));
+
+  const tbody = document.getElementById("links");
+  try {
+    const data = await api("GET", "/links?" + params);
+    if (!append) tbody.replaceChildren();
+    for (const link of data.links) tbody.append(linkRow(link));
+    list.nextBefore = data.nextBefore ?? null;
+  } catch (err) {
+    show("Could not load links: " + err.message, true);
+    return;
+  }
+  document.getElementById("more").hidden = list.nextBefore === null;
+  document.getElementById("empty").hidden = tbody.children.length > 0;
+}
+
+function linkRow(link) {
+  const row = document.getElementById("link-row").content.firstElementChild.cloneNode(true);
+  const shortLink = absolute(link.shortLink);
+
+  const short = row.querySelector("a.short");
+  short.href = shortLink;
+  short.textContent = link.shortId;
+  row.querySelector("button.copy").addEventListener("click", () => copy(shortLink));
+
+  row.querySelector(".title").textContent = link.title;
+  const href = row.querySelector("a.href");
+  href.href = link.href;
+  href.textContent = link.href;
+  href.title = link.href;
+
+  const tags = row.querySelector(".tags");
+  for (const tag of link.tags || []) {
+    const el = document.createElement("span");
+    el.className = "tag";
+    el.textContent = tag;
+    tags.append(el);
+  }
+
+  row.querySelector(".clicks").textContent = String(link.usageCount);
+  row.querySelector(".created").textContent = new Date(link.createdAt).toLocaleString();
+
+  row.querySelector("button.edit").addEventListener("click", () => row.replaceWith(editRow(link, row)));
+  row.querySelector("button.delete").addEventListener("click", async () => {
+    if (!window.confirm("Delete " + shortLink + "?")) return;
+    try {
+      await api("DELETE", "/links/" + encodeURIComponent(link.shortId));
+    } catch (err) {
+      show("Could not delete the link: " + err.message, true);
+      return;
+    }
+    row.remove();
+    document.getElementById("empty").hidden = document.getElementById("links").children.length > 0;
+    show("Deleted " + link.shortId);
+  });
+  return row;
+}
+
+function editRow(link, viewRow) {
+  const row = document.getElementById("edit-row").content.firstElementChild.cloneNode(true);
+  const href = row.querySelector('input[name="href"]');
+  const title = row.querySelector('input[name="title"]');
+  const tags = row.querySelector('input[name="tags"]');
+  row.querySelector(".short").textContent = link.shortId;
+  href.value = link.href;
+  title.value = link.title;
+  tags.value = (link.tags || []).join(", ");
+
+  row.querySelector("button.cancel").addEventListener("click", () => row.replaceWith(viewRow));
+  row.querySelector("button.save").addEventListener("click", async () => {
+    if (!href.reportValidity()) return;
+    const changes = {};
+    if (href.value !== link.href) changes.href = href.value;
+    if (title.value !== link.title) changes.title = title.value;
+    const newTags = parseTags(tags.value);
+    if (newTags.join(",") !== (link.tags || []).join(",")) changes.tags = newTags;
+    if (Object.keys(changes).length === 0) {
+      row.replaceWith(viewRow);
+      return;
+    }
+
+    let updated;
+    try {
+      updated = await api("PATCH", "/links/" + encodeURIComponent(link.shortId), changes);
+    } catch (err) {
+      show("Could not save the link: " + err.message, true);
+      return;
+    }
+    row.replaceWith(linkRow(updated));
+    show("Saved " + link.shortId);
+  });
+  return row;
+}
+
+// Shorten
+
+async function createLink(event) {
+  event.preventDefault();
+  const form = event.target;
+  const body = { href: form.elements.href.value };
+  if (form.elements.title.value) body.title = form.elements.title.value;
+  const tags = parseTags(form.elements.tags.value);
+  if (tags.length > 0) body.tags = tags;
+
+  let created;
+  try {
+    created = await api("POST", "/new", body);
+  } catch (err) {
+    show("Could not shorten the URL: " + err.message, true);
+    return;
+  }
+
+  const shortLink = absolute(created.shortLink);
+  const link = document.getElementById("created-link");
+  link.href = shortLink;
+  link.textContent = shortLink;
+  document.getElementById("created").hidden = false;
+  form.reset();
+  loadLinks(false);
+}
+
+document.addEventListener("DOMContentLoaded", () => {
+  document.getElementById("api-key").value = settings.apiKey;
+  document.getElementById("workspace").value = settings.workspace;
+  document.getElementById("save-settings").addEventListener("click", () => {
+    settings.save(document.getElementById("api-key").value.trim(), document.getElementById("workspace").value.trim());
+    document.getElementById("settings").open = false;
+    list.nextBefore = null;
+    loadLinks(false);
+  });
+
+  document.getElementById("create-form").addEventListener("submit", createLink);
+  document.getElementById("created-copy").addEventListener("click", () => {
+    copy(document.getElementById("created-link").href);
+  });
+  document.getElementById("filter-form").addEventListener("submit", (event) => {
+    event.preventDefault();
+    list.tag = event.target.elements.tag.value.trim();
+    list.nextBefore = null;
+    loadLinks(false);
+  });
+  document.getElementById("more").addEventListener("click", () => loadLinks(true));
+
+  loadLinks(false);
+});
@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// The UI is plain HTML, CSS and JavaScript calling the JSON API, so there
+// is nothing to build: the page loads its script and styles from /ui/{file}.
+
+//go:embed static/index.html
+var page []byte
+
+//go:embed static/app.js static/app.css
+var static embed.FS
+
+var assets, _ = fs.Sub(static, "static")
+
+type PageHandler struct{}
+
+func NewPageHandler() *PageHandler {
+	return new(PageHandler)
+}
+
+func (h *PageHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("content-type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(page)
+}
+
+type AssetHandler struct{}
+
+func NewAssetHandler() *AssetHandler {
+	return new(AssetHandler)
+}
+
+func (h *AssetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	http.ServeFileFS(w, r, assets, r.PathValue("file"))
+}
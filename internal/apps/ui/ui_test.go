@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlers(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /ui", NewPageHandler())
+	mux.Handle("GET /ui/{file}", NewAssetHandler())
+
+	tests := []struct {
+		path        string
+		status      int
+		contentType string
+	}{
+		{path: "/ui", status: http.StatusOK, contentType: "text/html"},
+		{path: "/ui/app.js", status: http.StatusOK, contentType: "text/javascript"},
+		{path: "/ui/app.css", status: http.StatusOK, contentType: "text/css"},
+		{path: "/ui/missing.js", status: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+		require.Equal(t, tt.status, w.Code, tt.path)
+		if tt.contentType != "" {
+			require.True(t, strings.HasPrefix(w.Header().Get("content-type"), tt.contentType), tt.path)
+		}
+	}
+}
+
+// TestPageAssets checks that the page only loads assets that are embedded.
+func TestPageAssets(t *testing.T) {
+	for _, name := range []string{"app.js", "app.css"} {
+		require.Contains(t, string(page), "/ui/"+name)
+		_, err := assets.Open(name)
+		require.NoError(t, err, name)
+	}
+}
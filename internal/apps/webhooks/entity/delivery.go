@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusDead      = "dead"
+)
+
+type Delivery struct {
+	ID            int64
+	WebhookID     int64
+	EventID       int64
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DeliveredAt   time.Time
+	CreatedAt     time.Time
+}
+
+// DeliveryJob is a claimed delivery together with its webhook and event.
+type DeliveryJob struct {
+	ID             int64
+	Attempts       int
+	URL            string
+	Secret         string
+	EventID        int64
+	EventType      string
+	Payload        []byte
+	EventCreatedAt time.Time
+}
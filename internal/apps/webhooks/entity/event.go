@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type Event struct {
+	ID          int64
+	WorkspaceID int64
+	Type        string
+	LinkID      int64
+	Payload     []byte
+	CreatedAt   time.Time
+}
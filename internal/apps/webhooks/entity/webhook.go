@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+type Webhook struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type DeleteWebhookHandler struct {
+	usecase usecase.IDeleteWebhookHandler
+}
+
+func NewDeleteWebhookHandler(usecase usecase.IDeleteWebhookHandler) *DeleteWebhookHandler {
+	return &DeleteWebhookHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *DeleteWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := pathID(r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	err = h.usecase.Handle(ctx, usecase.DeleteWebhookData{ID: id})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type DeliveryOutput struct {
+	ID            int64      `json:"id"`
+	EventID       int64      `json:"eventId"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"nextAttemptAt"`
+	LastError     string     `json:"lastError,omitempty"`
+	DeliveredAt   *time.Time `json:"deliveredAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type ListWebhookDeliveriesOutput struct {
+	Deliveries []DeliveryOutput `json:"deliveries"`
+}
+
+type ListWebhookDeliveriesHandler struct {
+	usecase usecase.IListWebhookDeliveriesHandler
+}
+
+func NewListWebhookDeliveriesHandler(usecase usecase.IListWebhookDeliveriesHandler) *ListWebhookDeliveriesHandler {
+	return &ListWebhookDeliveriesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListWebhookDeliveriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := pathID(r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.ListWebhookDeliveriesData{
+		WebhookID: id,
+		Status:    r.URL.Query().Get("status"),
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListWebhookDeliveriesOutput{Deliveries: make([]DeliveryOutput, 0, len(result.Deliveries))}
+	for _, d := range result.Deliveries {
+		delivery := DeliveryOutput{
+			ID:            d.ID,
+			EventID:       d.EventID,
+			Status:        d.Status,
+			Attempts:      d.Attempts,
+			NextAttemptAt: d.NextAttemptAt,
+			LastError:     d.LastError,
+			CreatedAt:     d.CreatedAt,
+		}
+		if !d.DeliveredAt.IsZero() {
+			delivery.DeliveredAt = &d.DeliveredAt
+		}
+		output.Deliveries = append(output.Deliveries, delivery)
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ListWebhooksOutput struct {
+	Webhooks []WebhookOutput `json:"webhooks"`
+}
+
+type ListWebhooksHandler struct {
+	usecase usecase.IListWebhooksHandler
+}
+
+func NewListWebhooksHandler(usecase usecase.IListWebhooksHandler) *ListWebhooksHandler {
+	return &ListWebhooksHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListWebhooksHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListWebhooksOutput{Webhooks: make([]WebhookOutput, 0, len(result.Webhooks))}
+	for _, webhook := range result.Webhooks {
+		output.Webhooks = append(output.Webhooks, toWebhookOutput(webhook))
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type RegisterWebhookInput struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"eventTypes"`
+}
+
+type RegisterWebhookOutput struct {
+	WebhookOutput
+	Secret string `json:"secret"`
+}
+
+type RegisterWebhookHandler struct {
+	usecase usecase.IRegisterWebhookHandler
+}
+
+func NewRegisterWebhookHandler(usecase usecase.IRegisterWebhookHandler) *RegisterWebhookHandler {
+	return &RegisterWebhookHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RegisterWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[RegisterWebhookInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.RegisterWebhookData(input))
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := RegisterWebhookOutput{
+		WebhookOutput: toWebhookOutput(result.Webhook),
+		Secret:        result.Webhook.Secret,
+	}
+	httpx.WriteJson(ctx, w, http.StatusCreated, output)
+}
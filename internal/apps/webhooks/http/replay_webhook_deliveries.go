@@ -0,0 +1,40 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ReplayWebhookDeliveriesOutput struct {
+	Replayed int64 `json:"replayed"`
+}
+
+type ReplayWebhookDeliveriesHandler struct {
+	usecase usecase.IReplayWebhookDeliveriesHandler
+}
+
+func NewReplayWebhookDeliveriesHandler(usecase usecase.IReplayWebhookDeliveriesHandler) *ReplayWebhookDeliveriesHandler {
+	return &ReplayWebhookDeliveriesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ReplayWebhookDeliveriesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := pathID(r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.ReplayWebhookDeliveriesData{WebhookID: id})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, ReplayWebhookDeliveriesOutput(result))
+}
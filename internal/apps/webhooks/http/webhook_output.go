@@ -0,0 +1,23 @@
+package http
+
+import (
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+)
+
+type WebhookOutput struct {
+	ID         int64     `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"eventTypes"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func toWebhookOutput(w entity.Webhook) WebhookOutput {
+	return WebhookOutput{
+		ID:         w.ID,
+		URL:        w.URL,
+		EventTypes: w.EventTypes,
+		CreatedAt:  w.CreatedAt,
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type DeleteWebhookData struct {
+	ID int64 `validate:"min=1"`
+}
+
+type IDeleteWebhookHandler interface {
+	Handle(ctx context.Context, data DeleteWebhookData) error
+}
+
+type DeleteWebhookHandler struct {
+	repoFactory usecase.RepoFactory[WebhookRepo]
+	validator   *validator.Validate
+}
+
+type DeleteWebhookParams struct {
+	RepoFactory usecase.RepoFactory[WebhookRepo]
+	Validator   *validator.Validate
+}
+
+func NewDeleteWebhookHandler(params DeleteWebhookParams) IDeleteWebhookHandler {
+	return &DeleteWebhookHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *DeleteWebhookHandler) Handle(ctx context.Context, data DeleteWebhookData) error {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return usecase.NewErrValidation("Invalid webhook id", err)
+	}
+	return h.repoFactory.GetRepo().DeleteWebhook(ctx, WorkspaceWebhookID{
+		ID:          data.ID,
+		WorkspaceID: principal.WorkspaceID,
+	})
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/webhook"
+)
+
+type IDispatchWebhooksHandler interface {
+	Handle(ctx context.Context) error
+}
+
+// DispatchWebhooksHandler fans outbox events out to subscribed webhooks and delivers due deliveries,
+// retrying failures with exponential backoff until MaxAttempts, after which a delivery is dead.
+type DispatchWebhooksHandler struct {
+	repoFactory usecase.RepoFactory[WebhookRepo]
+	sender      Sender
+	batchSize   int32
+	maxAttempts int
+	backoffBase time.Duration
+	backoffMax  time.Duration
+	lease       time.Duration
+}
+
+type DispatchWebhooksParams struct {
+	RepoFactory usecase.RepoFactory[WebhookRepo]
+	Sender      Sender
+	BatchSize   int32
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	Lease       time.Duration
+}
+
+func NewDispatchWebhooksHandler(params DispatchWebhooksParams) IDispatchWebhooksHandler {
+	return &DispatchWebhooksHandler{
+		repoFactory: params.RepoFactory,
+		sender:      params.Sender,
+		batchSize:   params.BatchSize,
+		maxAttempts: params.MaxAttempts,
+		backoffBase: params.BackoffBase,
+		backoffMax:  params.BackoffMax,
+		lease:       params.Lease,
+	}
+}
+
+type eventEnvelope struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func (h *DispatchWebhooksHandler) Handle(ctx context.Context) error {
+	if err := h.fanOut(ctx); err != nil {
+		return err
+	}
+	return h.deliver(ctx)
+}
+
+func (h *DispatchWebhooksHandler) fanOut(ctx context.Context) error {
+	return h.repoFactory.InTransaction(ctx, func(r WebhookRepo) error {
+		events, txErr := r.ListUndispatchedEvents(ctx, h.batchSize)
+		if txErr != nil {
+			return fmt.Errorf("repo.ListUndispatchedEvents: %w", txErr)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		if txErr := r.DispatchEvents(ctx, events); txErr != nil {
+			return fmt.Errorf("repo.DispatchEvents: %w", txErr)
+		}
+		return nil
+	})
+}
+
+func (h *DispatchWebhooksHandler) deliver(ctx context.Context) error {
+	repo := h.repoFactory.GetRepo()
+	jobs, err := repo.ClaimWebhookDeliveries(ctx, ClaimWebhookDeliveriesArgs{
+		LeaseUntil: time.Now().Add(h.lease),
+		BatchSize:  h.batchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("repo.ClaimWebhookDeliveries: %w", err)
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		sendErr := h.send(ctx, job)
+		if sendErr == nil {
+			if err := repo.MarkWebhookDeliveryDelivered(ctx, job.ID); err != nil {
+				return fmt.Errorf("repo.MarkWebhookDeliveryDelivered: %w", err)
+			}
+			continue
+		}
+
+		attempts := job.Attempts + 1
+		status := entity.DeliveryStatusPending
+		if attempts >= h.maxAttempts {
+			status = entity.DeliveryStatusDead
+		}
+		err := repo.MarkWebhookDeliveryFailed(ctx, MarkWebhookDeliveryFailedArgs{
+			ID:            job.ID,
+			Status:        status,
+			NextAttemptAt: time.Now().Add(h.backoff(attempts)),
+			LastError:     sendErr.Error(),
+		})
+		if err != nil {
+			return fmt.Errorf("repo.MarkWebhookDeliveryFailed: %w", err)
+		}
+	}
+	return nil
+}
+
+func (h *DispatchWebhooksHandler) send(ctx context.Context, job entity.DeliveryJob) error {
+	body, err := json.Marshal(eventEnvelope{
+		ID:        job.EventID,
+		Type:      job.EventType,
+		CreatedAt: job.EventCreatedAt,
+		Data:      job.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	return h.sender.Send(ctx, webhook.Request{
+		URL:    job.URL,
+		Secret: job.Secret,
+		ID:     strconv.FormatInt(job.EventID, 10),
+		Event:  job.EventType,
+		Body:   body,
+	})
+}
+
+func (h *DispatchWebhooksHandler) backoff(attempts int) time.Duration {
+	d := h.backoffBase
+	for i := 1; i < attempts && d < h.backoffMax; i++ {
+		d *= 2
+	}
+	return min(d, h.backoffMax)
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchWebhooksBackoff(t *testing.T) {
+	h := &DispatchWebhooksHandler{backoffBase: 5 * time.Second, backoffMax: time.Minute}
+
+	tests := []struct {
+		attempts int
+		exp      time.Duration
+	}{
+		{attempts: 1, exp: 5 * time.Second},
+		{attempts: 2, exp: 10 * time.Second},
+		{attempts: 4, exp: 40 * time.Second},
+		{attempts: 5, exp: time.Minute},
+		{attempts: 50, exp: time.Minute},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.exp, h.backoff(tt.attempts), "attempts=%d", tt.attempts)
+	}
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+const listWebhookDeliveriesLimit = 100
+
+type ListWebhookDeliveriesData struct {
+	WebhookID int64  `validate:"min=1"`
+	Status    string `validate:"omitempty,oneof=pending delivered dead"`
+}
+
+type ListWebhookDeliveriesResult struct {
+	Deliveries []entity.Delivery
+}
+
+type IListWebhookDeliveriesHandler interface {
+	Handle(ctx context.Context, data ListWebhookDeliveriesData) (ListWebhookDeliveriesResult, error)
+}
+
+type ListWebhookDeliveriesHandler struct {
+	repoFactory usecase.RepoFactory[WebhookRepo]
+	validator   *validator.Validate
+}
+
+type ListWebhookDeliveriesParams struct {
+	RepoFactory usecase.RepoFactory[WebhookRepo]
+	Validator   *validator.Validate
+}
+
+func NewListWebhookDeliveriesHandler(params ListWebhookDeliveriesParams) IListWebhookDeliveriesHandler {
+	return &ListWebhookDeliveriesHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *ListWebhookDeliveriesHandler) Handle(ctx context.Context, data ListWebhookDeliveriesData) (ListWebhookDeliveriesResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return ListWebhookDeliveriesResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return ListWebhookDeliveriesResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	repo := h.repoFactory.GetReadRepo(ctx)
+	if _, err := repo.GetWebhook(ctx, WorkspaceWebhookID{
+		ID:          data.WebhookID,
+		WorkspaceID: principal.WorkspaceID,
+	}); err != nil {
+		return ListWebhookDeliveriesResult{}, err
+	}
+
+	deliveries, err := repo.ListWebhookDeliveries(ctx, ListWebhookDeliveriesArgs{
+		WebhookID: data.WebhookID,
+		Status:    data.Status,
+		Limit:     listWebhookDeliveriesLimit,
+	})
+	if err != nil {
+		return ListWebhookDeliveriesResult{}, err
+	}
+	return ListWebhookDeliveriesResult{Deliveries: deliveries}, nil
+}
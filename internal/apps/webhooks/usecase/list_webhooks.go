@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ListWebhooksResult struct {
+	Webhooks []entity.Webhook
+}
+
+type IListWebhooksHandler interface {
+	Handle(ctx context.Context) (ListWebhooksResult, error)
+}
+
+type ListWebhooksHandler struct {
+	repoFactory usecase.RepoFactory[WebhookRepo]
+}
+
+type ListWebhooksParams struct {
+	RepoFactory usecase.RepoFactory[WebhookRepo]
+}
+
+func NewListWebhooksHandler(params ListWebhooksParams) IListWebhooksHandler {
+	return &ListWebhooksHandler{
+		repoFactory: params.RepoFactory,
+	}
+}
+
+func (h *ListWebhooksHandler) Handle(ctx context.Context) (ListWebhooksResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return ListWebhooksResult{}, err
+	}
+	webhooks, err := h.repoFactory.GetReadRepo(ctx).ListWebhooks(ctx, principal.WorkspaceID)
+	if err != nil {
+		return ListWebhooksResult{}, err
+	}
+	return ListWebhooksResult{Webhooks: webhooks}, nil
+}
@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type RegisterWebhookData struct {
+	URL        string   `validate:"required,http_url"`
+	EventTypes []string `validate:"omitempty,unique,dive,oneof=link.created link.updated link.deleted link.restored link.expired link.click_threshold_reached"`
+}
+
+type RegisterWebhookResult struct {
+	Webhook entity.Webhook
+}
+
+type IRegisterWebhookHandler interface {
+	Handle(ctx context.Context, data RegisterWebhookData) (RegisterWebhookResult, error)
+}
+
+type RegisterWebhookHandler struct {
+	repoFactory   usecase.RepoFactory[WebhookRepo]
+	validator     *validator.Validate
+	addressPolicy AddressPolicy
+}
+
+type RegisterWebhookParams struct {
+	RepoFactory   usecase.RepoFactory[WebhookRepo]
+	Validator     *validator.Validate
+	AddressPolicy AddressPolicy
+}
+
+func NewRegisterWebhookHandler(params RegisterWebhookParams) IRegisterWebhookHandler {
+	return &RegisterWebhookHandler{
+		repoFactory:   params.RepoFactory,
+		validator:     params.Validator,
+		addressPolicy: params.AddressPolicy,
+	}
+}
+
+func (h *RegisterWebhookHandler) Handle(ctx context.Context, data RegisterWebhookData) (RegisterWebhookResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return RegisterWebhookResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return RegisterWebhookResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+	if err := h.addressPolicy.CheckURL(ctx, data.URL); err != nil {
+		return RegisterWebhookResult{}, usecase.NewErrValidation("Webhook URL must resolve to a public address", err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return RegisterWebhookResult{}, fmt.Errorf("rand.Read: %w", err)
+	}
+
+	eventTypes := data.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	webhook, err := h.repoFactory.GetRepo().CreateWebhook(ctx, CreateWebhookArgs{
+		WorkspaceID: principal.WorkspaceID,
+		URL:         data.URL,
+		Secret:      hex.EncodeToString(secret),
+		EventTypes:  eventTypes,
+	})
+	if err != nil {
+		return RegisterWebhookResult{}, fmt.Errorf("repo.CreateWebhook: %w", err)
+	}
+	return RegisterWebhookResult{Webhook: webhook}, nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+type createWebhookRepo struct {
+	WebhookRepo
+	created []CreateWebhookArgs
+}
+
+func (r *createWebhookRepo) CreateWebhook(ctx context.Context, args CreateWebhookArgs) (entity.Webhook, error) {
+	r.created = append(r.created, args)
+	return entity.Webhook{URL: args.URL}, nil
+}
+
+type createWebhookRepoFactory struct {
+	usecase.RepoFactory[WebhookRepo]
+	repo *createWebhookRepo
+}
+
+func (f createWebhookRepoFactory) GetRepo() WebhookRepo {
+	return f.repo
+}
+
+func TestRegisterWebhookAddressPolicy(t *testing.T) {
+	policy := webhook.NewAddressPolicy([]netip.Prefix{netip.MustParsePrefix("10.20.0.0/16")})
+	ctx := usecase.WithPrincipal(context.Background(), usecase.Principal{WorkspaceID: 1, Role: usecase.RoleAdmin})
+
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://93.184.216.34/hook", allowed: true},
+		{url: "http://10.20.0.5/hook", allowed: true},
+		{url: "http://169.254.169.254/latest/meta-data/"},
+		{url: "http://127.0.0.1:8080/hook"},
+		{url: "http://192.168.0.10/hook"},
+		{url: "http://[::1]/hook"},
+	}
+	for _, tt := range tests {
+		repo := &createWebhookRepo{}
+		h := NewRegisterWebhookHandler(RegisterWebhookParams{
+			RepoFactory:   createWebhookRepoFactory{repo: repo},
+			Validator:     validator.New(),
+			AddressPolicy: policy,
+		})
+
+		_, err := h.Handle(ctx, RegisterWebhookData{URL: tt.url})
+		if tt.allowed {
+			require.NoError(t, err, tt.url)
+			require.Len(t, repo.created, 1, tt.url)
+			continue
+		}
+		var errValidation usecase.ErrValidation
+		require.ErrorAs(t, err, &errValidation, tt.url)
+		require.ErrorIs(t, err, webhook.ErrAddressNotAllowed, tt.url)
+		require.Empty(t, repo.created, tt.url)
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ReplayWebhookDeliveriesData struct {
+	WebhookID int64 `validate:"min=1"`
+}
+
+type ReplayWebhookDeliveriesResult struct {
+	Replayed int64
+}
+
+type IReplayWebhookDeliveriesHandler interface {
+	Handle(ctx context.Context, data ReplayWebhookDeliveriesData) (ReplayWebhookDeliveriesResult, error)
+}
+
+type ReplayWebhookDeliveriesHandler struct {
+	repoFactory usecase.RepoFactory[WebhookRepo]
+	validator   *validator.Validate
+}
+
+type ReplayWebhookDeliveriesParams struct {
+	RepoFactory usecase.RepoFactory[WebhookRepo]
+	Validator   *validator.Validate
+}
+
+func NewReplayWebhookDeliveriesHandler(params ReplayWebhookDeliveriesParams) IReplayWebhookDeliveriesHandler {
+	return &ReplayWebhookDeliveriesHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *ReplayWebhookDeliveriesHandler) Handle(ctx context.Context, data ReplayWebhookDeliveriesData) (ReplayWebhookDeliveriesResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return ReplayWebhookDeliveriesResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return ReplayWebhookDeliveriesResult{}, usecase.NewErrValidation("Invalid webhook id", err)
+	}
+
+	var replayed int64
+	err = h.repoFactory.InTransaction(ctx, func(r WebhookRepo) error {
+		if _, txErr := r.GetWebhook(ctx, WorkspaceWebhookID{
+			ID:          data.WebhookID,
+			WorkspaceID: principal.WorkspaceID,
+		}); txErr != nil {
+			return txErr
+		}
+		var txErr error
+		replayed, txErr = r.ReplayWebhookDeliveries(ctx, data.WebhookID)
+		return txErr
+	})
+	if err != nil {
+		return ReplayWebhookDeliveriesResult{}, err
+	}
+	return ReplayWebhookDeliveriesResult{Replayed: replayed}, nil
+}
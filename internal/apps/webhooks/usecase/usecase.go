@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/webhook"
+)
+
+type CreateWebhookArgs struct {
+	WorkspaceID int64
+	URL         string
+	Secret      string
+	EventTypes  []string
+}
+
+type WorkspaceWebhookID struct {
+	ID          int64
+	WorkspaceID int64
+}
+
+type ListWebhookDeliveriesArgs struct {
+	WebhookID int64
+	Status    string
+	Limit     int32
+}
+
+type ClaimWebhookDeliveriesArgs struct {
+	LeaseUntil time.Time
+	BatchSize  int32
+}
+
+type MarkWebhookDeliveryFailedArgs struct {
+	ID            int64
+	Status        string
+	NextAttemptAt time.Time
+	LastError     string
+}
+
+type WebhookRepo interface {
+	CreateWebhook(context.Context, CreateWebhookArgs) (entity.Webhook, error)
+	GetWebhook(context.Context, WorkspaceWebhookID) (entity.Webhook, error)
+	ListWebhooks(context.Context, int64) ([]entity.Webhook, error)
+	DeleteWebhook(context.Context, WorkspaceWebhookID) error
+	ListWebhookDeliveries(context.Context, ListWebhookDeliveriesArgs) ([]entity.Delivery, error)
+	ReplayWebhookDeliveries(context.Context, int64) (int64, error)
+	ListUndispatchedEvents(context.Context, int32) ([]entity.Event, error)
+	DispatchEvents(context.Context, []entity.Event) error
+	ClaimWebhookDeliveries(context.Context, ClaimWebhookDeliveriesArgs) ([]entity.DeliveryJob, error)
+	MarkWebhookDeliveryDelivered(context.Context, int64) error
+	MarkWebhookDeliveryFailed(context.Context, MarkWebhookDeliveryFailedArgs) error
+}
+
+// AddressPolicy refuses webhook URLs whose host resolves to an address
+// deliveries may not be sent to.
+type AddressPolicy interface {
+	CheckURL(ctx context.Context, url string) error
+}
+
+type Sender interface {
+	Send(ctx context.Context, req webhook.Request) error
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+// APIKey is stored by its hash; Fingerprint is the prefix of the hash that
+// the audit log records as the actor.
+type APIKey struct {
+	ID          int64
+	WorkspaceID int64
+	Name        string
+	Role        string
+	Fingerprint string
+	CreatedAt   time.Time
+}
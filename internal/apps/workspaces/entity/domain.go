@@ -0,0 +1,9 @@
+package entity
+
+import "time"
+
+type Domain struct {
+	Domain      string
+	WorkspaceID int64
+	CreatedAt   time.Time
+}
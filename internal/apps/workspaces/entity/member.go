@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type Member struct {
+	WorkspaceID int64
+	UserID      string
+	Role        string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type Workspace struct {
+	ID   int64
+	Slug string
+	Name string
+	// MaxLinks caps the links that are not deleted, 0 means no limit.
+	MaxLinks  int64
+	CreatedAt time.Time
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type AddDomainHandler struct {
+	usecase usecase.IAddDomainHandler
+}
+
+func NewAddDomainHandler(usecase usecase.IAddDomainHandler) *AddDomainHandler {
+	return &AddDomainHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *AddDomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx, usecase.AddDomainData{Domain: r.PathValue("domain")})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toDomainOutput(result.Domain))
+}
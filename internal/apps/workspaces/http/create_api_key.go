@@ -0,0 +1,45 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type CreateAPIKeyInput struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+type CreateAPIKeyHandler struct {
+	usecase usecase.ICreateAPIKeyHandler
+}
+
+func NewCreateAPIKeyHandler(usecase usecase.ICreateAPIKeyHandler) *CreateAPIKeyHandler {
+	return &CreateAPIKeyHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *CreateAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[CreateAPIKeyInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.CreateAPIKeyData(input))
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := CreatedAPIKeyOutput{
+		APIKeyOutput: toAPIKeyOutput(result.APIKey),
+		Key:          result.Key,
+	}
+	httpx.WriteJson(ctx, w, http.StatusCreated, output)
+}
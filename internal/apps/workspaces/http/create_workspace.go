@@ -0,0 +1,54 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type CreateWorkspaceInput struct {
+	Slug     string `json:"slug"`
+	Name     string `json:"name"`
+	MaxLinks int64  `json:"maxLinks"`
+}
+
+type CreateWorkspaceOutput struct {
+	Workspace WorkspaceOutput     `json:"workspace"`
+	APIKey    CreatedAPIKeyOutput `json:"apiKey"`
+}
+
+type CreateWorkspaceHandler struct {
+	usecase usecase.ICreateWorkspaceHandler
+}
+
+func NewCreateWorkspaceHandler(usecase usecase.ICreateWorkspaceHandler) *CreateWorkspaceHandler {
+	return &CreateWorkspaceHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *CreateWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[CreateWorkspaceInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.CreateWorkspaceData(input))
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := CreateWorkspaceOutput{
+		Workspace: toWorkspaceOutput(result.Workspace),
+		APIKey: CreatedAPIKeyOutput{
+			APIKeyOutput: toAPIKeyOutput(result.APIKey),
+			Key:          result.Key,
+		},
+	}
+	httpx.WriteJson(ctx, w, http.StatusCreated, output)
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type GetWorkspaceOutput struct {
+	WorkspaceOutput
+	Role    string         `json:"role"`
+	Links   int64          `json:"links"`
+	Domains []DomainOutput `json:"domains"`
+}
+
+type GetWorkspaceHandler struct {
+	usecase usecase.IGetWorkspaceHandler
+}
+
+func NewGetWorkspaceHandler(usecase usecase.IGetWorkspaceHandler) *GetWorkspaceHandler {
+	return &GetWorkspaceHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *GetWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := GetWorkspaceOutput{
+		WorkspaceOutput: toWorkspaceOutput(result.Workspace),
+		Role:            result.Role,
+		Links:           result.Links,
+		Domains:         make([]DomainOutput, 0, len(result.Domains)),
+	}
+	for _, domain := range result.Domains {
+		output.Domains = append(output.Domains, toDomainOutput(domain))
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ListAPIKeysOutput struct {
+	APIKeys []APIKeyOutput `json:"apiKeys"`
+}
+
+type ListAPIKeysHandler struct {
+	usecase usecase.IListAPIKeysHandler
+}
+
+func NewListAPIKeysHandler(usecase usecase.IListAPIKeysHandler) *ListAPIKeysHandler {
+	return &ListAPIKeysHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListAPIKeysHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListAPIKeysOutput{APIKeys: make([]APIKeyOutput, 0, len(result.APIKeys))}
+	for _, key := range result.APIKeys {
+		output.APIKeys = append(output.APIKeys, toAPIKeyOutput(key))
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
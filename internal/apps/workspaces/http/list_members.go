@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ListMembersOutput struct {
+	Members []MemberOutput `json:"members"`
+}
+
+type ListMembersHandler struct {
+	usecase usecase.IListMembersHandler
+}
+
+func NewListMembersHandler(usecase usecase.IListMembersHandler) *ListMembersHandler {
+	return &ListMembersHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListMembersHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListMembersOutput{Members: make([]MemberOutput, 0, len(result.Members))}
+	for _, member := range result.Members {
+		output.Members = append(output.Members, toMemberOutput(member))
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type ListWorkspacesOutput struct {
+	Workspaces []WorkspaceOutput `json:"workspaces"`
+}
+
+type ListWorkspacesHandler struct {
+	usecase usecase.IListWorkspacesHandler
+}
+
+func NewListWorkspacesHandler(usecase usecase.IListWorkspacesHandler) *ListWorkspacesHandler {
+	return &ListWorkspacesHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *ListWorkspacesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.Handle(ctx)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	output := ListWorkspacesOutput{Workspaces: make([]WorkspaceOutput, 0, len(result.Workspaces))}
+	for _, workspace := range result.Workspaces {
+		output.Workspaces = append(output.Workspaces, toWorkspaceOutput(workspace))
+	}
+	httpx.WriteJson(ctx, w, http.StatusOK, output)
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func pathID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, usecase.NewErrValidation("Invalid API key id", err)
+	}
+	return id, nil
+}
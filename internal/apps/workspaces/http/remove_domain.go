@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type RemoveDomainHandler struct {
+	usecase usecase.IRemoveDomainHandler
+}
+
+func NewRemoveDomainHandler(usecase usecase.IRemoveDomainHandler) *RemoveDomainHandler {
+	return &RemoveDomainHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RemoveDomainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.usecase.Handle(ctx, usecase.RemoveDomainData{Domain: r.PathValue("domain")})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
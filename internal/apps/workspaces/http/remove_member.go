@@ -0,0 +1,30 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type RemoveMemberHandler struct {
+	usecase usecase.IRemoveMemberHandler
+}
+
+func NewRemoveMemberHandler(usecase usecase.IRemoveMemberHandler) *RemoveMemberHandler {
+	return &RemoveMemberHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RemoveMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	err := h.usecase.Handle(ctx, usecase.RemoveMemberData{UserID: r.PathValue("user")})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type RevokeAPIKeyHandler struct {
+	usecase usecase.IRevokeAPIKeyHandler
+}
+
+func NewRevokeAPIKeyHandler(usecase usecase.IRevokeAPIKeyHandler) *RevokeAPIKeyHandler {
+	return &RevokeAPIKeyHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *RevokeAPIKeyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id, err := pathID(r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	err = h.usecase.Handle(ctx, usecase.RevokeAPIKeyData{ID: id})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type SetMemberInput struct {
+	Role string `json:"role"`
+}
+
+type SetMemberHandler struct {
+	usecase usecase.ISetMemberHandler
+}
+
+func NewSetMemberHandler(usecase usecase.ISetMemberHandler) *SetMemberHandler {
+	return &SetMemberHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *SetMemberHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[SetMemberInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.SetMemberData{
+		UserID: r.PathValue("user"),
+		Role:   input.Role,
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toMemberOutput(result.Member))
+}
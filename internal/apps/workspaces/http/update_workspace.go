@@ -0,0 +1,45 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	httpx "github.com/kirillismad/go-url-shortener/internal/pkg/http"
+)
+
+type UpdateWorkspaceInput struct {
+	Name     *string `json:"name"`
+	MaxLinks *int64  `json:"maxLinks"`
+}
+
+type UpdateWorkspaceHandler struct {
+	usecase usecase.IUpdateWorkspaceHandler
+}
+
+func NewUpdateWorkspaceHandler(usecase usecase.IUpdateWorkspaceHandler) *UpdateWorkspaceHandler {
+	return &UpdateWorkspaceHandler{
+		usecase: usecase,
+	}
+}
+
+func (h *UpdateWorkspaceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	input, err := httpx.ReadJson[UpdateWorkspaceInput](ctx, r)
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	result, err := h.usecase.Handle(ctx, usecase.UpdateWorkspaceData{
+		Slug:     r.PathValue("slug"),
+		Name:     input.Name,
+		MaxLinks: input.MaxLinks,
+	})
+	if err != nil {
+		httpx.HandleError(ctx, w, err)
+		return
+	}
+
+	httpx.WriteJson(ctx, w, http.StatusOK, toWorkspaceOutput(result.Workspace))
+}
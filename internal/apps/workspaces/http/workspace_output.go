@@ -0,0 +1,75 @@
+package http
+
+import (
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+)
+
+type WorkspaceOutput struct {
+	ID        int64     `json:"id"`
+	Slug      string    `json:"slug"`
+	Name      string    `json:"name"`
+	MaxLinks  int64     `json:"maxLinks"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func toWorkspaceOutput(w entity.Workspace) WorkspaceOutput {
+	return WorkspaceOutput{
+		ID:        w.ID,
+		Slug:      w.Slug,
+		Name:      w.Name,
+		MaxLinks:  w.MaxLinks,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+type MemberOutput struct {
+	UserID    string    `json:"userId"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func toMemberOutput(m entity.Member) MemberOutput {
+	return MemberOutput{
+		UserID:    m.UserID,
+		Role:      m.Role,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+type APIKeyOutput struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Role        string    `json:"role"`
+	Fingerprint string    `json:"fingerprint"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func toAPIKeyOutput(k entity.APIKey) APIKeyOutput {
+	return APIKeyOutput{
+		ID:          k.ID,
+		Name:        k.Name,
+		Role:        k.Role,
+		Fingerprint: k.Fingerprint,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+// CreatedAPIKeyOutput is the only response carrying the key itself.
+type CreatedAPIKeyOutput struct {
+	APIKeyOutput
+	Key string `json:"key"`
+}
+
+type DomainOutput struct {
+	Domain    string    `json:"domain"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func toDomainOutput(d entity.Domain) DomainOutput {
+	return DomainOutput{
+		Domain:    d.Domain,
+		CreatedAt: d.CreatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type AddDomainData struct {
+	Domain string `validate:"required,max=253,fqdn"`
+}
+
+type AddDomainResult struct {
+	Domain entity.Domain
+}
+
+type IAddDomainHandler interface {
+	Handle(ctx context.Context, data AddDomainData) (AddDomainResult, error)
+}
+
+// AddDomainHandler registers a custom short domain of the workspace of the
+// caller. Redirects on it only resolve the links of that workspace.
+type AddDomainHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type AddDomainParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewAddDomainHandler(params AddDomainParams) IAddDomainHandler {
+	return &AddDomainHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *AddDomainHandler) Handle(ctx context.Context, data AddDomainData) (AddDomainResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return AddDomainResult{}, err
+	}
+	data.Domain = strings.ToLower(data.Domain)
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return AddDomainResult{}, usecase.NewErrValidation("Invalid domain", err)
+	}
+
+	domain, err := h.repoFactory.GetRepo().AddWorkspaceDomain(ctx, WorkspaceDomain{
+		Domain:      data.Domain,
+		WorkspaceID: principal.WorkspaceID,
+	})
+	if errors.Is(err, usecase.ErrNoResult) {
+		return AddDomainResult{}, ErrDomainTaken
+	}
+	if err != nil {
+		return AddDomainResult{}, fmt.Errorf("repo.AddWorkspaceDomain: %w", err)
+	}
+	return AddDomainResult{Domain: domain}, nil
+}
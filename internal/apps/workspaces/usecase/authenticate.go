@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+// AuthenticateHandler resolves the principal of a request. An API key acts
+// in its workspace with its role. A user, named by a bearer token or else by
+// the proxy, acts in the workspace named by its slug, the default one when none
+// is given, with their member role or the role their token grants there,
+// whichever is higher. Anonymous callers, and users that are not members,
+// get anonymousRole in the default workspace and no role elsewhere.
+type AuthenticateHandler struct {
+	repoFactory   usecase.RepoFactory[WorkspaceRepo]
+	tokens        usecase.TokenVerifier
+	anonymousRole string
+}
+
+type AuthenticateParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	// Tokens verifies bearer tokens, nil rejects them.
+	Tokens        usecase.TokenVerifier
+	AnonymousRole string
+}
+
+func NewAuthenticateHandler(params AuthenticateParams) usecase.Authenticator {
+	return &AuthenticateHandler{
+		repoFactory:   params.RepoFactory,
+		tokens:        params.Tokens,
+		anonymousRole: params.AnonymousRole,
+	}
+}
+
+func (h *AuthenticateHandler) Authenticate(ctx context.Context, credentials usecase.Credentials) (usecase.Principal, error) {
+	repo := h.repoFactory.GetRepo()
+	identity := usecase.Identity{User: credentials.User}
+	switch {
+	case credentials.APIKey != "":
+		key, err := repo.GetAPIKeyByHash(ctx, HashAPIKey(credentials.APIKey))
+		if errors.Is(err, usecase.ErrNoResult) {
+			return usecase.Principal{}, ErrInvalidAPIKey
+		}
+		if err != nil {
+			return usecase.Principal{}, fmt.Errorf("repo.GetAPIKeyByHash: %w", err)
+		}
+		return usecase.Principal{
+			Actor:       usecase.APIKeyActor(credentials.APIKey),
+			WorkspaceID: key.WorkspaceID,
+			Role:        key.Role,
+		}, nil
+	case credentials.Token != "":
+		var err error
+		identity, err = h.verifyToken(ctx, credentials.Token)
+		if err != nil {
+			return usecase.Principal{}, err
+		}
+	}
+
+	principal := usecase.Principal{WorkspaceID: usecase.DefaultWorkspaceID}
+	if credentials.Workspace != "" {
+		workspace, err := repo.GetWorkspaceBySlug(ctx, credentials.Workspace)
+		if errors.Is(err, usecase.ErrNoResult) {
+			return usecase.Principal{}, ErrWorkspaceNotFound
+		}
+		if err != nil {
+			return usecase.Principal{}, fmt.Errorf("repo.GetWorkspaceBySlug: %w", err)
+		}
+		principal.WorkspaceID = workspace.ID
+	}
+	if principal.WorkspaceID == usecase.DefaultWorkspaceID {
+		principal.Role = h.anonymousRole
+	}
+
+	if identity.User == "" {
+		principal.Actor = usecase.Actor{Type: usecase.ActorAnonymous, ID: credentials.ClientIP}
+		return principal, nil
+	}
+
+	principal.Actor = usecase.Actor{Type: usecase.ActorUser, ID: identity.User}
+	member, err := repo.GetWorkspaceMember(ctx, WorkspaceMemberID{
+		WorkspaceID: principal.WorkspaceID,
+		UserID:      identity.User,
+	})
+	switch {
+	case err == nil:
+		principal.Role = member.Role
+	case !errors.Is(err, usecase.ErrNoResult):
+		return usecase.Principal{}, fmt.Errorf("repo.GetWorkspaceMember: %w", err)
+	}
+	principal.Role = usecase.HigherRole(principal.Role, tokenRole(identity.Roles, principal.WorkspaceID, credentials.Workspace))
+	return principal, nil
+}
+
+func (h *AuthenticateHandler) verifyToken(ctx context.Context, token string) (usecase.Identity, error) {
+	if h.tokens == nil {
+		return usecase.Identity{}, ErrInvalidToken
+	}
+	identity, err := h.tokens.VerifyToken(ctx, token)
+	if errors.Is(err, usecase.ErrUnauthorized) {
+		return usecase.Identity{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if err != nil {
+		return usecase.Identity{}, fmt.Errorf("tokens.VerifyToken: %w", err)
+	}
+	return identity, nil
+}
+
+// tokenRole is the highest role a token grants in a workspace. A plain role
+// applies to the default workspace, "<slug>:<role>" to the workspace slug.
+func tokenRole(roles []string, workspaceID int64, slug string) string {
+	var role string
+	for _, r := range roles {
+		workspace, name, scoped := strings.Cut(r, ":")
+		switch {
+		case !scoped && workspaceID == usecase.DefaultWorkspaceID:
+			role = usecase.HigherRole(role, r)
+		case scoped && slug != "" && workspace == slug:
+			role = usecase.HigherRole(role, name)
+		}
+	}
+	return role
+}
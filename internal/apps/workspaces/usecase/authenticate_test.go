@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+type authRepo struct {
+	WorkspaceRepo
+	workspaces map[string]entity.Workspace
+	members    map[WorkspaceMemberID]entity.Member
+	keys       map[string]entity.APIKey
+}
+
+func (r *authRepo) GetWorkspaceBySlug(ctx context.Context, slug string) (entity.Workspace, error) {
+	w, ok := r.workspaces[slug]
+	if !ok {
+		return entity.Workspace{}, usecase.ErrNoResult
+	}
+	return w, nil
+}
+
+func (r *authRepo) GetWorkspaceMember(ctx context.Context, id WorkspaceMemberID) (entity.Member, error) {
+	m, ok := r.members[id]
+	if !ok {
+		return entity.Member{}, usecase.ErrNoResult
+	}
+	return m, nil
+}
+
+func (r *authRepo) GetAPIKeyByHash(ctx context.Context, keyHash string) (entity.APIKey, error) {
+	k, ok := r.keys[keyHash]
+	if !ok {
+		return entity.APIKey{}, usecase.ErrNoResult
+	}
+	return k, nil
+}
+
+type repoFactory struct {
+	repo WorkspaceRepo
+}
+
+func (f repoFactory) GetRepo() WorkspaceRepo                        { return f.repo }
+func (f repoFactory) GetReadRepo(ctx context.Context) WorkspaceRepo { return f.repo }
+func (f repoFactory) InTransaction(ctx context.Context, txFn func(r WorkspaceRepo) error) error {
+	return txFn(f.repo)
+}
+
+type tokenVerifier map[string]usecase.Identity
+
+func (v tokenVerifier) VerifyToken(ctx context.Context, token string) (usecase.Identity, error) {
+	identity, ok := v[token]
+	if !ok {
+		return usecase.Identity{}, fmt.Errorf("%w: bad signature", usecase.ErrUnauthorized)
+	}
+	return identity, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	repo := &authRepo{
+		workspaces: map[string]entity.Workspace{"acme": {ID: 2, Slug: "acme"}},
+		members: map[WorkspaceMemberID]entity.Member{
+			{WorkspaceID: 2, UserID: "alice"}:                          {Role: usecase.RoleEditor},
+			{WorkspaceID: usecase.DefaultWorkspaceID, UserID: "carol"}: {Role: usecase.RoleViewer},
+		},
+		keys: map[string]entity.APIKey{HashAPIKey("secret"): {WorkspaceID: 2, Role: usecase.RoleViewer}},
+	}
+	h := NewAuthenticateHandler(AuthenticateParams{
+		RepoFactory: repoFactory{repo: repo},
+		Tokens: tokenVerifier{
+			"alice": {User: "alice", Roles: []string{"viewer", "acme:admin", "other:admin"}},
+			"carol": {User: "carol", Roles: []string{"editor", "offline_access"}},
+			"dave":  {User: "dave"},
+		},
+		AnonymousRole: usecase.RoleAdmin,
+	})
+
+	alice := usecase.Actor{Type: usecase.ActorUser, ID: "alice"}
+	anonymous := usecase.Actor{Type: usecase.ActorAnonymous, ID: "192.0.2.1"}
+	tests := []struct {
+		name        string
+		credentials usecase.Credentials
+		principal   usecase.Principal
+		err         error
+	}{
+		{
+			name:        "api key",
+			credentials: usecase.Credentials{APIKey: "secret", Workspace: "other"},
+			principal:   usecase.Principal{Actor: usecase.APIKeyActor("secret"), WorkspaceID: 2, Role: usecase.RoleViewer},
+		},
+		{
+			name:        "unknown api key",
+			credentials: usecase.Credentials{APIKey: "revoked"},
+			err:         ErrInvalidAPIKey,
+		},
+		{
+			name:        "member",
+			credentials: usecase.Credentials{User: "alice", Workspace: "acme"},
+			principal:   usecase.Principal{Actor: alice, WorkspaceID: 2, Role: usecase.RoleEditor},
+		},
+		{
+			name:        "api key wins over a proxy user",
+			credentials: usecase.Credentials{User: "alice", APIKey: "secret", Workspace: "acme"},
+			principal:   usecase.Principal{Actor: usecase.APIKeyActor("secret"), WorkspaceID: 2, Role: usecase.RoleViewer},
+		},
+		{
+			name:        "member role wins in the default workspace",
+			credentials: usecase.Credentials{User: "carol"},
+			principal:   usecase.Principal{Actor: usecase.Actor{Type: usecase.ActorUser, ID: "carol"}, WorkspaceID: usecase.DefaultWorkspaceID, Role: usecase.RoleViewer},
+		},
+		{
+			name:        "user without membership",
+			credentials: usecase.Credentials{User: "alice"},
+			principal:   usecase.Principal{Actor: alice, WorkspaceID: usecase.DefaultWorkspaceID, Role: usecase.RoleAdmin},
+		},
+		{
+			name:        "anonymous",
+			credentials: usecase.Credentials{ClientIP: "192.0.2.1"},
+			principal:   usecase.Principal{Actor: anonymous, WorkspaceID: usecase.DefaultWorkspaceID, Role: usecase.RoleAdmin},
+		},
+		{
+			name:        "anonymous in another workspace",
+			credentials: usecase.Credentials{Workspace: "acme", ClientIP: "192.0.2.1"},
+			principal:   usecase.Principal{Actor: anonymous, WorkspaceID: 2},
+		},
+		{
+			name:        "token role wins over a lower member role",
+			credentials: usecase.Credentials{Token: "alice", Workspace: "acme"},
+			principal:   usecase.Principal{Actor: alice, WorkspaceID: 2, Role: usecase.RoleAdmin},
+		},
+		{
+			name:        "plain token role applies to the default workspace",
+			credentials: usecase.Credentials{Token: "carol"},
+			principal:   usecase.Principal{Actor: usecase.Actor{Type: usecase.ActorUser, ID: "carol"}, WorkspaceID: usecase.DefaultWorkspaceID, Role: usecase.RoleEditor},
+		},
+		{
+			name:        "token without roles",
+			credentials: usecase.Credentials{Token: "dave", Workspace: "acme"},
+			principal:   usecase.Principal{Actor: usecase.Actor{Type: usecase.ActorUser, ID: "dave"}, WorkspaceID: 2},
+		},
+		{
+			name:        "invalid token",
+			credentials: usecase.Credentials{Token: "expired"},
+			err:         ErrInvalidToken,
+		},
+		{
+			name:        "api key wins over a token",
+			credentials: usecase.Credentials{Token: "expired", APIKey: "secret"},
+			principal:   usecase.Principal{Actor: usecase.APIKeyActor("secret"), WorkspaceID: 2, Role: usecase.RoleViewer},
+		},
+		{
+			name:        "token wins over a proxy user",
+			credentials: usecase.Credentials{User: "alice", Token: "carol", Workspace: "acme"},
+			principal:   usecase.Principal{Actor: usecase.Actor{Type: usecase.ActorUser, ID: "carol"}, WorkspaceID: 2},
+		},
+		{
+			name:        "unknown workspace",
+			credentials: usecase.Credentials{User: "alice", Workspace: "nope"},
+			err:         ErrWorkspaceNotFound,
+		},
+	}
+	for _, tt := range tests {
+		principal, err := h.Authenticate(context.Background(), tt.credentials)
+		require.ErrorIs(t, err, tt.err, tt.name)
+		require.Equal(t, tt.principal, principal, tt.name)
+	}
+}
+
+func TestAuthenticateWithoutTokens(t *testing.T) {
+	h := NewAuthenticateHandler(AuthenticateParams{
+		RepoFactory:   repoFactory{repo: &authRepo{}},
+		AnonymousRole: usecase.RoleAdmin,
+	})
+	_, err := h.Authenticate(context.Background(), usecase.Credentials{Token: "alice"})
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type CreateAPIKeyData struct {
+	Name string `validate:"required,max=100"`
+	Role string `validate:"required,oneof=viewer editor admin"`
+}
+
+type CreateAPIKeyResult struct {
+	APIKey entity.APIKey
+	// Key is only returned here, the service keeps its hash.
+	Key string
+}
+
+type ICreateAPIKeyHandler interface {
+	Handle(ctx context.Context, data CreateAPIKeyData) (CreateAPIKeyResult, error)
+}
+
+type CreateAPIKeyHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type CreateAPIKeyParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewCreateAPIKeyHandler(params CreateAPIKeyParams) ICreateAPIKeyHandler {
+	return &CreateAPIKeyHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *CreateAPIKeyHandler) Handle(ctx context.Context, data CreateAPIKeyData) (CreateAPIKeyResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return CreateAPIKeyResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return CreateAPIKeyResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		return CreateAPIKeyResult{}, err
+	}
+	apiKey, err := h.repoFactory.GetRepo().CreateAPIKey(ctx, CreateAPIKeyArgs{
+		WorkspaceID: principal.WorkspaceID,
+		Name:        data.Name,
+		Role:        data.Role,
+		KeyHash:     HashAPIKey(key),
+	})
+	if err != nil {
+		return CreateAPIKeyResult{}, fmt.Errorf("repo.CreateAPIKey: %w", err)
+	}
+	return CreateAPIKeyResult{APIKey: apiKey, Key: key}, nil
+}
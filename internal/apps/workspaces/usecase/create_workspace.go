@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type CreateWorkspaceData struct {
+	Slug     string `validate:"required,min=2,max=63,lowercase,hostname_rfc1123,excludes=."`
+	Name     string `validate:"required,max=200"`
+	MaxLinks int64  `validate:"min=0"`
+}
+
+type CreateWorkspaceResult struct {
+	Workspace entity.Workspace
+	// APIKey is an admin key of the new workspace; Key is only returned here.
+	APIKey entity.APIKey
+	Key    string
+}
+
+type ICreateWorkspaceHandler interface {
+	Handle(ctx context.Context, data CreateWorkspaceData) (CreateWorkspaceResult, error)
+}
+
+// CreateWorkspaceHandler creates a workspace together with a first admin API
+// key, through which its members and other keys are managed.
+type CreateWorkspaceHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type CreateWorkspaceParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewCreateWorkspaceHandler(params CreateWorkspaceParams) ICreateWorkspaceHandler {
+	return &CreateWorkspaceHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *CreateWorkspaceHandler) Handle(ctx context.Context, data CreateWorkspaceData) (CreateWorkspaceResult, error) {
+	if _, err := usecase.AuthorizeOperator(ctx); err != nil {
+		return CreateWorkspaceResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return CreateWorkspaceResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	key, err := generateAPIKey()
+	if err != nil {
+		return CreateWorkspaceResult{}, err
+	}
+
+	result := CreateWorkspaceResult{Key: key}
+	err = h.repoFactory.InTransaction(ctx, func(repo WorkspaceRepo) error {
+		var txErr error
+		result.Workspace, txErr = repo.CreateWorkspace(ctx, CreateWorkspaceArgs{
+			Slug:     data.Slug,
+			Name:     data.Name,
+			MaxLinks: data.MaxLinks,
+		})
+		if errors.Is(txErr, usecase.ErrNoResult) {
+			return ErrWorkspaceSlugTaken
+		}
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateWorkspace: %w", txErr)
+		}
+
+		result.APIKey, txErr = repo.CreateAPIKey(ctx, CreateAPIKeyArgs{
+			WorkspaceID: result.Workspace.ID,
+			Name:        "admin",
+			Role:        usecase.RoleAdmin,
+			KeyHash:     HashAPIKey(key),
+		})
+		if txErr != nil {
+			return fmt.Errorf("repo.CreateAPIKey: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return CreateWorkspaceResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+var (
+	ErrInvalidAPIKey      = usecase.NewError(usecase.ErrUnauthorized, "invalid_api_key", "invalid API key")
+	ErrInvalidToken       = usecase.NewError(usecase.ErrUnauthorized, "invalid_token", "invalid bearer token")
+	ErrWorkspaceNotFound  = usecase.NewError(usecase.ErrNoResult, "workspace_not_found", "workspace not found")
+	ErrWorkspaceSlugTaken = usecase.NewError(usecase.ErrConflict, "workspace_slug_taken", "workspace slug is taken")
+	ErrDomainTaken        = usecase.NewError(usecase.ErrConflict, "domain_taken", "domain belongs to another workspace")
+)
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type GetWorkspaceResult struct {
+	Workspace entity.Workspace
+	Role      string
+	Links     int64
+	Domains   []entity.Domain
+}
+
+type IGetWorkspaceHandler interface {
+	Handle(ctx context.Context) (GetWorkspaceResult, error)
+}
+
+// GetWorkspaceHandler describes the workspace of the caller: its quota and
+// usage, its custom domains and the caller's role.
+type GetWorkspaceHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+type GetWorkspaceParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+func NewGetWorkspaceHandler(params GetWorkspaceParams) IGetWorkspaceHandler {
+	return &GetWorkspaceHandler{
+		repoFactory: params.RepoFactory,
+	}
+}
+
+func (h *GetWorkspaceHandler) Handle(ctx context.Context) (GetWorkspaceResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleViewer)
+	if err != nil {
+		return GetWorkspaceResult{}, err
+	}
+
+	repo := h.repoFactory.GetReadRepo(ctx)
+	workspace, err := repo.GetWorkspace(ctx, principal.WorkspaceID)
+	if err != nil {
+		return GetWorkspaceResult{}, fmt.Errorf("repo.GetWorkspace: %w", err)
+	}
+	links, err := repo.CountWorkspaceLinks(ctx, principal.WorkspaceID)
+	if err != nil {
+		return GetWorkspaceResult{}, fmt.Errorf("repo.CountWorkspaceLinks: %w", err)
+	}
+	domains, err := repo.ListWorkspaceDomains(ctx, principal.WorkspaceID)
+	if err != nil {
+		return GetWorkspaceResult{}, fmt.Errorf("repo.ListWorkspaceDomains: %w", err)
+	}
+
+	return GetWorkspaceResult{
+		Workspace: workspace,
+		Role:      principal.Role,
+		Links:     links,
+		Domains:   domains,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ListAPIKeysResult struct {
+	APIKeys []entity.APIKey
+}
+
+type IListAPIKeysHandler interface {
+	Handle(ctx context.Context) (ListAPIKeysResult, error)
+}
+
+type ListAPIKeysHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+type ListAPIKeysParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+func NewListAPIKeysHandler(params ListAPIKeysParams) IListAPIKeysHandler {
+	return &ListAPIKeysHandler{
+		repoFactory: params.RepoFactory,
+	}
+}
+
+func (h *ListAPIKeysHandler) Handle(ctx context.Context) (ListAPIKeysResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return ListAPIKeysResult{}, err
+	}
+	keys, err := h.repoFactory.GetReadRepo(ctx).ListAPIKeys(ctx, principal.WorkspaceID)
+	if err != nil {
+		return ListAPIKeysResult{}, err
+	}
+	return ListAPIKeysResult{APIKeys: keys}, nil
+}
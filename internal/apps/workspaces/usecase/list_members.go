@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ListMembersResult struct {
+	Members []entity.Member
+}
+
+type IListMembersHandler interface {
+	Handle(ctx context.Context) (ListMembersResult, error)
+}
+
+type ListMembersHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+type ListMembersParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+func NewListMembersHandler(params ListMembersParams) IListMembersHandler {
+	return &ListMembersHandler{
+		repoFactory: params.RepoFactory,
+	}
+}
+
+func (h *ListMembersHandler) Handle(ctx context.Context) (ListMembersResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return ListMembersResult{}, err
+	}
+	members, err := h.repoFactory.GetReadRepo(ctx).ListWorkspaceMembers(ctx, principal.WorkspaceID)
+	if err != nil {
+		return ListMembersResult{}, err
+	}
+	return ListMembersResult{Members: members}, nil
+}
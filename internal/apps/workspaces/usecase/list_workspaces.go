@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type ListWorkspacesResult struct {
+	Workspaces []entity.Workspace
+}
+
+type IListWorkspacesHandler interface {
+	Handle(ctx context.Context) (ListWorkspacesResult, error)
+}
+
+type ListWorkspacesHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+type ListWorkspacesParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+}
+
+func NewListWorkspacesHandler(params ListWorkspacesParams) IListWorkspacesHandler {
+	return &ListWorkspacesHandler{
+		repoFactory: params.RepoFactory,
+	}
+}
+
+func (h *ListWorkspacesHandler) Handle(ctx context.Context) (ListWorkspacesResult, error) {
+	if _, err := usecase.AuthorizeOperator(ctx); err != nil {
+		return ListWorkspacesResult{}, err
+	}
+	workspaces, err := h.repoFactory.GetReadRepo(ctx).ListWorkspaces(ctx)
+	if err != nil {
+		return ListWorkspacesResult{}, err
+	}
+	return ListWorkspacesResult{Workspaces: workspaces}, nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type RemoveDomainData struct {
+	Domain string `validate:"required,max=253"`
+}
+
+type IRemoveDomainHandler interface {
+	Handle(ctx context.Context, data RemoveDomainData) error
+}
+
+type RemoveDomainHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type RemoveDomainParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewRemoveDomainHandler(params RemoveDomainParams) IRemoveDomainHandler {
+	return &RemoveDomainHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *RemoveDomainHandler) Handle(ctx context.Context, data RemoveDomainData) error {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	data.Domain = strings.ToLower(data.Domain)
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return usecase.NewErrValidation("Invalid domain", err)
+	}
+	return h.repoFactory.GetRepo().DeleteWorkspaceDomain(ctx, WorkspaceDomain{
+		Domain:      data.Domain,
+		WorkspaceID: principal.WorkspaceID,
+	})
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type RemoveMemberData struct {
+	UserID string `validate:"required,max=256"`
+}
+
+type IRemoveMemberHandler interface {
+	Handle(ctx context.Context, data RemoveMemberData) error
+}
+
+type RemoveMemberHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type RemoveMemberParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewRemoveMemberHandler(params RemoveMemberParams) IRemoveMemberHandler {
+	return &RemoveMemberHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *RemoveMemberHandler) Handle(ctx context.Context, data RemoveMemberData) error {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return usecase.NewErrValidation("Invalid request", err)
+	}
+	return h.repoFactory.GetRepo().DeleteWorkspaceMember(ctx, WorkspaceMemberID{
+		WorkspaceID: principal.WorkspaceID,
+		UserID:      data.UserID,
+	})
+}
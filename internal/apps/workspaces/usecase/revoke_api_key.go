@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type RevokeAPIKeyData struct {
+	ID int64 `validate:"min=1"`
+}
+
+type IRevokeAPIKeyHandler interface {
+	Handle(ctx context.Context, data RevokeAPIKeyData) error
+}
+
+type RevokeAPIKeyHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type RevokeAPIKeyParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewRevokeAPIKeyHandler(params RevokeAPIKeyParams) IRevokeAPIKeyHandler {
+	return &RevokeAPIKeyHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *RevokeAPIKeyHandler) Handle(ctx context.Context, data RevokeAPIKeyData) error {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return usecase.NewErrValidation("Invalid API key id", err)
+	}
+	return h.repoFactory.GetRepo().DeleteAPIKey(ctx, WorkspaceAPIKeyID{
+		ID:          data.ID,
+		WorkspaceID: principal.WorkspaceID,
+	})
+}
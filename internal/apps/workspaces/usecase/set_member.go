@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type SetMemberData struct {
+	UserID string `validate:"required,max=256"`
+	Role   string `validate:"required,oneof=viewer editor admin"`
+}
+
+type SetMemberResult struct {
+	Member entity.Member
+}
+
+type ISetMemberHandler interface {
+	Handle(ctx context.Context, data SetMemberData) (SetMemberResult, error)
+}
+
+// SetMemberHandler adds a user to the workspace of the caller or changes
+// their role.
+type SetMemberHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type SetMemberParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewSetMemberHandler(params SetMemberParams) ISetMemberHandler {
+	return &SetMemberHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *SetMemberHandler) Handle(ctx context.Context, data SetMemberData) (SetMemberResult, error) {
+	principal, err := usecase.Authorize(ctx, usecase.RoleAdmin)
+	if err != nil {
+		return SetMemberResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return SetMemberResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	member, err := h.repoFactory.GetRepo().SetWorkspaceMember(ctx, SetWorkspaceMemberArgs{
+		WorkspaceID: principal.WorkspaceID,
+		UserID:      data.UserID,
+		Role:        data.Role,
+	})
+	if err != nil {
+		return SetMemberResult{}, fmt.Errorf("repo.SetWorkspaceMember: %w", err)
+	}
+	return SetMemberResult{Member: member}, nil
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type UpdateWorkspaceData struct {
+	Slug     string  `validate:"required"`
+	Name     *string `validate:"omitnil,min=1,max=200"`
+	MaxLinks *int64  `validate:"omitnil,min=0"`
+}
+
+type UpdateWorkspaceResult struct {
+	Workspace entity.Workspace
+}
+
+type IUpdateWorkspaceHandler interface {
+	Handle(ctx context.Context, data UpdateWorkspaceData) (UpdateWorkspaceResult, error)
+}
+
+// UpdateWorkspaceHandler renames a workspace or changes its link quota. A
+// lower quota does not remove links, it only stops new ones.
+type UpdateWorkspaceHandler struct {
+	repoFactory usecase.RepoFactory[WorkspaceRepo]
+	validator   *validator.Validate
+}
+
+type UpdateWorkspaceParams struct {
+	RepoFactory usecase.RepoFactory[WorkspaceRepo]
+	Validator   *validator.Validate
+}
+
+func NewUpdateWorkspaceHandler(params UpdateWorkspaceParams) IUpdateWorkspaceHandler {
+	return &UpdateWorkspaceHandler{
+		repoFactory: params.RepoFactory,
+		validator:   params.Validator,
+	}
+}
+
+func (h *UpdateWorkspaceHandler) Handle(ctx context.Context, data UpdateWorkspaceData) (UpdateWorkspaceResult, error) {
+	if _, err := usecase.AuthorizeOperator(ctx); err != nil {
+		return UpdateWorkspaceResult{}, err
+	}
+	if err := h.validator.StructCtx(ctx, data); err != nil {
+		return UpdateWorkspaceResult{}, usecase.NewErrValidation("Invalid request", err)
+	}
+
+	var workspace entity.Workspace
+	err := h.repoFactory.InTransaction(ctx, func(repo WorkspaceRepo) error {
+		var txErr error
+		workspace, txErr = repo.GetWorkspaceBySlug(ctx, data.Slug)
+		if errors.Is(txErr, usecase.ErrNoResult) {
+			return ErrWorkspaceNotFound
+		}
+		if txErr != nil {
+			return fmt.Errorf("repo.GetWorkspaceBySlug: %w", txErr)
+		}
+
+		args := UpdateWorkspaceArgs{
+			ID:       workspace.ID,
+			Name:     workspace.Name,
+			MaxLinks: workspace.MaxLinks,
+		}
+		if data.Name != nil {
+			args.Name = *data.Name
+		}
+		if data.MaxLinks != nil {
+			args.MaxLinks = *data.MaxLinks
+		}
+		workspace, txErr = repo.UpdateWorkspace(ctx, args)
+		if txErr != nil {
+			return fmt.Errorf("repo.UpdateWorkspace: %w", txErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return UpdateWorkspaceResult{}, err
+	}
+	return UpdateWorkspaceResult{Workspace: workspace}, nil
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+)
+
+type CreateWorkspaceArgs struct {
+	Slug     string
+	Name     string
+	MaxLinks int64
+}
+
+type UpdateWorkspaceArgs struct {
+	ID       int64
+	Name     string
+	MaxLinks int64
+}
+
+type WorkspaceMemberID struct {
+	WorkspaceID int64
+	UserID      string
+}
+
+type SetWorkspaceMemberArgs struct {
+	WorkspaceID int64
+	UserID      string
+	Role        string
+}
+
+type CreateAPIKeyArgs struct {
+	WorkspaceID int64
+	Name        string
+	Role        string
+	KeyHash     string
+}
+
+type WorkspaceAPIKeyID struct {
+	ID          int64
+	WorkspaceID int64
+}
+
+type WorkspaceDomain struct {
+	Domain      string
+	WorkspaceID int64
+}
+
+type WorkspaceRepo interface {
+	CreateWorkspace(context.Context, CreateWorkspaceArgs) (entity.Workspace, error)
+	GetWorkspace(context.Context, int64) (entity.Workspace, error)
+	GetWorkspaceBySlug(context.Context, string) (entity.Workspace, error)
+	ListWorkspaces(context.Context) ([]entity.Workspace, error)
+	UpdateWorkspace(context.Context, UpdateWorkspaceArgs) (entity.Workspace, error)
+	CountWorkspaceLinks(context.Context, int64) (int64, error)
+	SetWorkspaceMember(context.Context, SetWorkspaceMemberArgs) (entity.Member, error)
+	GetWorkspaceMember(context.Context, WorkspaceMemberID) (entity.Member, error)
+	ListWorkspaceMembers(context.Context, int64) ([]entity.Member, error)
+	DeleteWorkspaceMember(context.Context, WorkspaceMemberID) error
+	CreateAPIKey(context.Context, CreateAPIKeyArgs) (entity.APIKey, error)
+	GetAPIKeyByHash(context.Context, string) (entity.APIKey, error)
+	ListAPIKeys(context.Context, int64) ([]entity.APIKey, error)
+	DeleteAPIKey(context.Context, WorkspaceAPIKeyID) error
+	AddWorkspaceDomain(context.Context, WorkspaceDomain) (entity.Domain, error)
+	ListWorkspaceDomains(context.Context, int64) ([]entity.Domain, error)
+	DeleteWorkspaceDomain(context.Context, WorkspaceDomain) error
+}
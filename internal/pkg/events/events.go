@@ -0,0 +1,27 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Message is an outbox row as seen by publishers. Key groups messages that
+// must be delivered in order (the link id for link events).
+type Message struct {
+	ID        int64           `json:"id"`
+	Type      string          `json:"type"`
+	Key       string          `json:"key"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+type Outbox interface {
+	LockOutbox(ctx context.Context) (bool, error)
+	ListUnpublishedEvents(ctx context.Context, limit int32) ([]Message, error)
+	MarkEventsPublished(ctx context.Context, ids []int64) error
+}
@@ -0,0 +1,50 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryBus keeps published messages in memory and fans them out to
+// subscribers. It is meant for tests and local development.
+type MemoryBus struct {
+	mu          sync.Mutex
+	messages    []Message
+	subscribers []chan Message
+}
+
+func NewMemoryBus() *MemoryBus {
+	return &MemoryBus{}
+}
+
+func (b *MemoryBus) Publish(ctx context.Context, msg Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.messages = append(b.messages, msg)
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe returns a channel receiving messages published from now on.
+// Messages are dropped for a subscriber whose buffer is full.
+func (b *MemoryBus) Subscribe(buffer int) <-chan Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan Message, buffer)
+	b.subscribers = append(b.subscribers, ch)
+	return ch
+}
+
+func (b *MemoryBus) Messages() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]Message(nil), b.messages...)
+}
@@ -0,0 +1,79 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+type Relay struct {
+	repoFactory usecase.RepoFactory[Outbox]
+	publisher   Publisher
+	batchSize   int32
+}
+
+type RelayParams struct {
+	RepoFactory usecase.RepoFactory[Outbox]
+	Publisher   Publisher
+	BatchSize   int32
+}
+
+func NewRelay(params RelayParams) *Relay {
+	return &Relay{
+		repoFactory: params.RepoFactory,
+		publisher:   params.Publisher,
+		batchSize:   params.BatchSize,
+	}
+}
+
+// Handle publishes one batch of outbox rows in id order. Rows are marked as
+// published only after the publisher accepted them, so a crash in between
+// leads to a redelivery (at-least-once). A failed message holds back the
+// rest of the batch with the same key to keep per-key ordering.
+func (r *Relay) Handle(ctx context.Context) error {
+	var publishErr error
+	err := r.repoFactory.InTransaction(ctx, func(outbox Outbox) error {
+		locked, txErr := outbox.LockOutbox(ctx)
+		if txErr != nil {
+			return fmt.Errorf("outbox.LockOutbox: %w", txErr)
+		}
+		if !locked {
+			return nil
+		}
+
+		messages, txErr := outbox.ListUnpublishedEvents(ctx, r.batchSize)
+		if txErr != nil {
+			return fmt.Errorf("outbox.ListUnpublishedEvents: %w", txErr)
+		}
+
+		published := make([]int64, 0, len(messages))
+		blocked := make(map[string]struct{})
+		for _, msg := range messages {
+			if ctx.Err() != nil {
+				break
+			}
+			if _, ok := blocked[msg.Key]; ok {
+				continue
+			}
+			if err := r.publisher.Publish(ctx, msg); err != nil {
+				blocked[msg.Key] = struct{}{}
+				publishErr = errors.Join(publishErr, fmt.Errorf("publish event %d: %w", msg.ID, err))
+				continue
+			}
+			published = append(published, msg.ID)
+		}
+
+		if len(published) > 0 {
+			if txErr := outbox.MarkEventsPublished(ctx, published); txErr != nil {
+				return fmt.Errorf("outbox.MarkEventsPublished: %w", txErr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return publishErr
+}
@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type memoryOutbox struct {
+	messages  []Message
+	published map[int64]bool
+	locked    bool
+}
+
+func (o *memoryOutbox) LockOutbox(ctx context.Context) (bool, error) {
+	return !o.locked, nil
+}
+
+func (o *memoryOutbox) ListUnpublishedEvents(ctx context.Context, limit int32) ([]Message, error) {
+	var result []Message
+	for _, msg := range o.messages {
+		if !o.published[msg.ID] && len(result) < int(limit) {
+			result = append(result, msg)
+		}
+	}
+	return result, nil
+}
+
+func (o *memoryOutbox) MarkEventsPublished(ctx context.Context, ids []int64) error {
+	for _, id := range ids {
+		o.published[id] = true
+	}
+	return nil
+}
+
+type memoryOutboxFactory struct {
+	outbox *memoryOutbox
+}
+
+func (f memoryOutboxFactory) GetRepo() Outbox {
+	return f.outbox
+}
+
+func (f memoryOutboxFactory) GetReadRepo(ctx context.Context) Outbox {
+	return f.outbox
+}
+
+func (f memoryOutboxFactory) InTransaction(ctx context.Context, txFn func(Outbox) error) error {
+	return txFn(f.outbox)
+}
+
+type failingPublisher struct {
+	Publisher
+	failID int64
+}
+
+func (p failingPublisher) Publish(ctx context.Context, msg Message) error {
+	if msg.ID == p.failID {
+		return errors.New("sink unavailable")
+	}
+	return p.Publisher.Publish(ctx, msg)
+}
+
+func ids(messages []Message) []int64 {
+	result := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		result = append(result, msg.ID)
+	}
+	return result
+}
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+	outbox := &memoryOutbox{
+		messages: []Message{
+			{ID: 1, Key: "a"},
+			{ID: 2, Key: "b"},
+			{ID: 3, Key: "a"},
+			{ID: 4, Key: "b"},
+			{ID: 5, Key: "c"},
+		},
+		published: make(map[int64]bool),
+	}
+	bus := NewMemoryBus()
+	sub := bus.Subscribe(10)
+
+	relay := NewRelay(RelayParams{
+		RepoFactory: memoryOutboxFactory{outbox: outbox},
+		Publisher:   failingPublisher{Publisher: bus, failID: 2},
+		BatchSize:   10,
+	})
+	err := relay.Handle(ctx)
+	require.Error(t, err)
+	require.Equal(t, []int64{1, 3, 5}, ids(bus.Messages()))
+	require.Equal(t, Message{ID: 1, Key: "a"}, <-sub)
+
+	relay = NewRelay(RelayParams{
+		RepoFactory: memoryOutboxFactory{outbox: outbox},
+		Publisher:   bus,
+		BatchSize:   10,
+	})
+	require.NoError(t, relay.Handle(ctx))
+	require.Equal(t, []int64{1, 3, 5, 2, 4}, ids(bus.Messages()))
+
+	require.NoError(t, relay.Handle(ctx))
+	require.Len(t, bus.Messages(), 5)
+}
+
+func TestRelaySkipsWhenLocked(t *testing.T) {
+	outbox := &memoryOutbox{
+		messages:  []Message{{ID: 1, Key: "a"}},
+		published: make(map[int64]bool),
+		locked:    true,
+	}
+	bus := NewMemoryBus()
+
+	relay := NewRelay(RelayParams{
+		RepoFactory: memoryOutboxFactory{outbox: outbox},
+		Publisher:   bus,
+		BatchSize:   10,
+	})
+	require.NoError(t, relay.Handle(context.Background()))
+	require.Empty(t, bus.Messages())
+}
@@ -0,0 +1,25 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// WriterPublisher writes messages as newline-delimited JSON.
+type WriterPublisher struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{enc: json.NewEncoder(w)}
+}
+
+func (p *WriterPublisher) Publish(ctx context.Context, msg Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.enc.Encode(msg)
+}
@@ -0,0 +1,49 @@
+package geoip
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+type NoopResolver struct{}
+
+func (NoopResolver) Country(context.Context, string) (string, error) {
+	return "", nil
+}
+
+// MaxMindResolver looks countries up in a local MaxMind DB file (GeoLite2/GeoIP2 Country or City).
+type MaxMindResolver struct {
+	reader *maxminddb.Reader
+}
+
+func OpenMaxMind(path string) (*MaxMindResolver, error) {
+	reader, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("maxminddb.Open: %w", err)
+	}
+	return &MaxMindResolver{reader: reader}, nil
+}
+
+func (r *MaxMindResolver) Country(_ context.Context, ip string) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", nil
+	}
+
+	var record struct {
+		Country struct {
+			ISOCode string `maxminddb:"iso_code"`
+		} `maxminddb:"country"`
+	}
+	if err := r.reader.Lookup(parsed, &record); err != nil {
+		return "", fmt.Errorf("reader.Lookup: %w", err)
+	}
+	return record.Country.ISOCode, nil
+}
+
+func (r *MaxMindResolver) Close() error {
+	return r.reader.Close()
+}
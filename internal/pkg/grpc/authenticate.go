@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"strings"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// AuthInterceptor authenticates calls like the HTTP Authenticate middleware,
+// from the x-api-key, authorization, x-workspace and, for calls of
+// trustedProxies, userHeader metadata.
+func AuthInterceptor(authenticator usecase.Authenticator, userHeader string, trustedProxies []netip.Prefix) grpc.UnaryServerInterceptor {
+	userHeader = strings.ToLower(userHeader)
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, err := authenticator.Authenticate(ctx, callCredentials(ctx, userHeader, trustedProxies))
+		if err != nil {
+			return nil, HandleError(ctx, err)
+		}
+		return handler(usecase.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func callCredentials(ctx context.Context, userHeader string, trustedProxies []netip.Prefix) usecase.Credentials {
+	md, _ := metadata.FromIncomingContext(ctx)
+	credentials := usecase.Credentials{
+		Token:     bearerToken(first(md.Get("authorization"))),
+		APIKey:    first(md.Get("x-api-key")),
+		Workspace: first(md.Get("x-workspace")),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		credentials.ClientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(credentials.ClientIP); err == nil {
+			credentials.ClientIP = host
+		}
+	}
+	if userHeader != "" && trusted(trustedProxies, credentials.ClientIP) {
+		credentials.User = first(md.Get(userHeader))
+	}
+	return credentials
+}
+
+func bearerToken(authorization string) string {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// trusted reports whether ip is in one of prefixes.
+func trusted(prefixes []netip.Prefix, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type authenticatorFunc func(ctx context.Context, credentials usecase.Credentials) (usecase.Principal, error)
+
+func (f authenticatorFunc) Authenticate(ctx context.Context, credentials usecase.Credentials) (usecase.Principal, error) {
+	return f(ctx, credentials)
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	proxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+	tests := []struct {
+		name        string
+		peer        string
+		md          metadata.MD
+		credentials usecase.Credentials
+	}{
+		{
+			name:        "proxy user",
+			peer:        "10.1.2.3",
+			md:          metadata.Pairs("x-forwarded-user", "alice", "x-workspace", "acme"),
+			credentials: usecase.Credentials{User: "alice", Workspace: "acme", ClientIP: "10.1.2.3"},
+		},
+		{
+			name:        "user header from an untrusted address",
+			peer:        "192.0.2.1",
+			md:          metadata.Pairs("x-forwarded-user", "alice"),
+			credentials: usecase.Credentials{ClientIP: "192.0.2.1"},
+		},
+		{
+			name:        "api key and bearer token",
+			peer:        "192.0.2.1",
+			md:          metadata.Pairs("x-api-key", "key", "authorization", "Bearer eyJ.eyJ.sig"),
+			credentials: usecase.Credentials{APIKey: "key", Token: "eyJ.eyJ.sig", ClientIP: "192.0.2.1"},
+		},
+	}
+	for _, tt := range tests {
+		var credentials usecase.Credentials
+		authenticator := authenticatorFunc(func(ctx context.Context, c usecase.Credentials) (usecase.Principal, error) {
+			credentials = c
+			return usecase.Principal{}, nil
+		})
+		interceptor := AuthInterceptor(authenticator, "X-Forwarded-User", proxies)
+
+		ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 4321}})
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			return nil, nil
+		})
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.credentials, credentials, tt.name)
+	}
+}
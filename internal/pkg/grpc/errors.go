@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func HandleError(ctx context.Context, err error) error {
+	var errValidation usecase.ErrValidation
+	switch {
+	case errors.As(err, &errValidation):
+		return status.Error(codes.InvalidArgument, errValidation.Error())
+	case errors.Is(err, usecase.ErrNoResult):
+		return status.Error(codes.NotFound, "not found")
+	case errors.Is(err, usecase.ErrGone):
+		return status.Error(codes.FailedPrecondition, "gone")
+	case errors.Is(err, usecase.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	case errors.Is(err, usecase.ErrForbidden):
+		return status.Error(codes.PermissionDenied, "forbidden")
+	case errors.Is(err, usecase.ErrConflict):
+		return status.Error(codes.AlreadyExists, "conflict")
+	case errors.Is(err, usecase.ErrTooManyRequests):
+		return status.Error(codes.ResourceExhausted, "too many requests")
+	case errors.Is(err, usecase.ErrUnavailable):
+		return status.Error(codes.Unavailable, "unavailable")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		log.Printf("grpc: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
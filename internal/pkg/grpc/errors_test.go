@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandleError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{err: usecase.NewErrValidation("Invalid request", errors.New("bad")), code: codes.InvalidArgument},
+		{err: fmt.Errorf("repo: %w", usecase.ErrNoResult), code: codes.NotFound},
+		{err: fmt.Errorf("%w: limit", usecase.ErrGone), code: codes.FailedPrecondition},
+		{err: usecase.ErrUnauthorized, code: codes.Unauthenticated},
+		{err: usecase.ErrForbidden, code: codes.PermissionDenied},
+		{err: usecase.ErrConflict, code: codes.AlreadyExists},
+		{err: usecase.ErrTooManyRequests, code: codes.ResourceExhausted},
+		{err: context.DeadlineExceeded, code: codes.DeadlineExceeded},
+		{err: errors.New("boom"), code: codes.Internal},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.code, status.Code(HandleError(context.Background(), tt.err)), tt.err.Error())
+	}
+}
@@ -0,0 +1,23 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestHost returns the lowercased host of the call without the port, from
+// the :authority pseudo-header or, if absent, the host metadata.
+func RequestHost(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	host := first(md.Get(":authority"))
+	if host == "" {
+		host = first(md.Get("host"))
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestHost(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		host string
+	}{
+		{name: "authority", md: metadata.Pairs(":authority", "Go.Example.com:443"), host: "go.example.com"},
+		{name: "authority wins over host", md: metadata.Pairs(":authority", "go.example.com", "host", "other.example.com"), host: "go.example.com"},
+		{name: "host", md: metadata.Pairs("host", "go.example.com"), host: "go.example.com"},
+		{name: "ipv6", md: metadata.Pairs(":authority", "[::1]:50051"), host: "::1"},
+		{name: "none", md: metadata.MD{}, host: ""},
+	}
+	for _, tt := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+		require.Equal(t, tt.host, RequestHost(ctx), tt.name)
+	}
+}
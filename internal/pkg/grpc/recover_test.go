@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecoverInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/links.v1.LinkService/CreateLink"}
+	interceptor := RecoverInterceptor()
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		var m map[string]int
+		m["boom"]++
+		return nil, nil
+	})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	resp, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+}
@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func PingDB(db *sql.DB) Check {
+	return db.PingContext
+}
+
+// MigrationVersion fails unless the database schema is clean and at the
+// latest version embedded in the binary.
+func MigrationVersion(db *sql.DB, table string, latest uint) Check {
+	query := fmt.Sprintf(`SELECT version, dirty FROM %q LIMIT 1`, table)
+	return func(ctx context.Context) error {
+		var (
+			version uint
+			dirty   bool
+		)
+		if err := db.QueryRowContext(ctx, query).Scan(&version, &dirty); err != nil {
+			return fmt.Errorf("db.QueryRowContext: %w", err)
+		}
+		if dirty {
+			return fmt.Errorf("migration %d is dirty", version)
+		}
+		if version != latest {
+			return fmt.Errorf("migration version %d, expected %d", version, latest)
+		}
+		return nil
+	}
+}
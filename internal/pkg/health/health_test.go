@@ -0,0 +1,37 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadiness(t *testing.T) {
+	r := require.New(t)
+
+	readiness := NewReadiness(50*time.Millisecond).
+		Add("db", func(ctx context.Context) error { return nil }).
+		Add("sink", func(ctx context.Context) error { return errors.New("connection refused") }).
+		Add("slow", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+	report := readiness.Run(context.Background())
+	r.Equal(StatusDown, report.Status)
+	r.Len(report.Checks, 3)
+	r.Equal(StatusUp, report.Checks[0].Status)
+	r.Equal("connection refused", report.Checks[1].Error)
+	r.Equal(context.DeadlineExceeded.Error(), report.Checks[2].Error)
+	r.GreaterOrEqual(report.Checks[2].LatencyMs, float64(50))
+
+	readiness.Drain()
+	r.Equal(Report{Status: StatusDraining, Checks: []CheckResult{}}, readiness.Run(context.Background()))
+}
+
+func TestReadinessWithoutChecks(t *testing.T) {
+	require.Equal(t, StatusUp, NewReadiness(time.Second).Run(context.Background()).Status)
+}
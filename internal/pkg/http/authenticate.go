@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+const (
+	APIKeyHeader    = "X-API-Key"
+	WorkspaceHeader = "X-Workspace"
+)
+
+// Authenticate resolves the principal of the request and stores it in the
+// context. The caller is the API key in X-API-Key, the bearer token in
+// Authorization or the user named in userHeader, which is only read from
+// requests of trustedProxies, the authenticating proxies in front of the
+// service; X-Workspace picks the workspace of a user. Other requests are
+// anonymous and identified by the client IP.
+func Authenticate(authenticator usecase.Authenticator, userHeader string, trustedProxies []netip.Prefix, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := authenticator.Authenticate(r.Context(), requestCredentials(r, userHeader, trustedProxies))
+		if err != nil {
+			HandleError(r.Context(), w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(usecase.WithPrincipal(r.Context(), principal)))
+	})
+}
+
+func requestCredentials(r *http.Request, userHeader string, trustedProxies []netip.Prefix) usecase.Credentials {
+	credentials := usecase.Credentials{
+		Token:     bearerToken(r.Header.Get("Authorization")),
+		APIKey:    r.Header.Get(APIKeyHeader),
+		Workspace: r.Header.Get(WorkspaceHeader),
+		ClientIP:  ClientIP(r),
+	}
+	if userHeader != "" && trusted(trustedProxies, peerIP(r)) {
+		credentials.User = r.Header.Get(userHeader)
+	}
+	return credentials
+}
+
+func bearerToken(authorization string) string {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
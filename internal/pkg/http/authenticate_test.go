@@ -0,0 +1,149 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+type authenticatorFunc func(ctx context.Context, credentials usecase.Credentials) (usecase.Principal, error)
+
+func (f authenticatorFunc) Authenticate(ctx context.Context, credentials usecase.Credentials) (usecase.Principal, error) {
+	return f(ctx, credentials)
+}
+
+func TestAuthenticate(t *testing.T) {
+	proxies := []netip.Prefix{netip.MustParsePrefix("192.0.2.0/24")}
+	tests := []struct {
+		name           string
+		userHeader     string
+		trustedProxies []netip.Prefix
+		headers        map[string]string
+		credentials    usecase.Credentials
+	}{
+		{
+			name:           "proxy user",
+			userHeader:     "X-Forwarded-User",
+			trustedProxies: proxies,
+			headers:        map[string]string{"X-Forwarded-User": "alice", WorkspaceHeader: "acme"},
+			credentials: usecase.Credentials{
+				User:      "alice",
+				Workspace: "acme",
+				ClientIP:  "192.0.2.1",
+			},
+		},
+		{
+			name:           "user header from an untrusted address",
+			userHeader:     "X-Forwarded-User",
+			trustedProxies: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")},
+			headers:        map[string]string{"X-Forwarded-User": "alice"},
+			credentials:    usecase.Credentials{ClientIP: "192.0.2.1"},
+		},
+		{
+			name:           "user header disabled",
+			trustedProxies: proxies,
+			headers:        map[string]string{"X-Forwarded-User": "alice", APIKeyHeader: "key"},
+			credentials:    usecase.Credentials{APIKey: "key", ClientIP: "192.0.2.1"},
+		},
+		{
+			name:        "bearer token",
+			headers:     map[string]string{"Authorization": "bearer eyJ.eyJ.sig", WorkspaceHeader: "acme"},
+			credentials: usecase.Credentials{Token: "eyJ.eyJ.sig", Workspace: "acme", ClientIP: "192.0.2.1"},
+		},
+		{
+			name:        "basic authorization",
+			headers:     map[string]string{"Authorization": "Basic YWxpY2U6c2VjcmV0"},
+			credentials: usecase.Credentials{ClientIP: "192.0.2.1"},
+		},
+		{
+			name:        "anonymous",
+			credentials: usecase.Credentials{ClientIP: "192.0.2.1"},
+		},
+	}
+	for _, tt := range tests {
+		var credentials usecase.Credentials
+		principal := usecase.Principal{
+			Actor:       usecase.Actor{Type: usecase.ActorUser, ID: "alice"},
+			WorkspaceID: 2,
+			Role:        usecase.RoleViewer,
+		}
+		authenticator := authenticatorFunc(func(ctx context.Context, c usecase.Credentials) (usecase.Principal, error) {
+			credentials = c
+			return principal, nil
+		})
+
+		var got usecase.Principal
+		handler := Authenticate(authenticator, tt.userHeader, tt.trustedProxies, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ = usecase.PrincipalFrom(r.Context())
+		}))
+
+		r := httptest.NewRequest(http.MethodPatch, "/links/abc", nil)
+		for k, v := range tt.headers {
+			r.Header.Set(k, v)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		require.Equal(t, tt.credentials, credentials, tt.name)
+		require.Equal(t, principal, got, tt.name)
+	}
+}
+
+func TestAuthenticateRejects(t *testing.T) {
+	authenticator := authenticatorFunc(func(ctx context.Context, c usecase.Credentials) (usecase.Principal, error) {
+		return usecase.Principal{}, usecase.ErrUnauthorized
+	})
+	handler := Authenticate(authenticator, "", nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("next handler called")
+	}))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/links", nil)
+	r.Header.Set(APIKeyHeader, "revoked")
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestAuthenticateUntrustedUserHeader checks that a client cannot act as a
+// member by setting the proxy user header itself.
+func TestAuthenticateUntrustedUserHeader(t *testing.T) {
+	authenticator := authenticatorFunc(func(ctx context.Context, c usecase.Credentials) (usecase.Principal, error) {
+		if c.User == "alice" {
+			return usecase.Principal{
+				Actor:       usecase.Actor{Type: usecase.ActorUser, ID: "alice"},
+				WorkspaceID: usecase.DefaultWorkspaceID,
+				Role:        usecase.RoleAdmin,
+			}, nil
+		}
+		return usecase.Principal{
+			Actor:       usecase.Actor{Type: usecase.ActorAnonymous, ID: c.ClientIP},
+			WorkspaceID: usecase.DefaultWorkspaceID,
+		}, nil
+	})
+	proxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		role       string
+	}{
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:4321", role: usecase.RoleAdmin},
+		{name: "client", remoteAddr: "192.0.2.1:1234", role: ""},
+		{name: "ipv4-mapped trusted proxy", remoteAddr: "[::ffff:10.1.2.3]:4321", role: usecase.RoleAdmin},
+	}
+	for _, tt := range tests {
+		var got usecase.Principal
+		handler := Authenticate(authenticator, "X-Forwarded-User", proxies, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ = usecase.PrincipalFrom(r.Context())
+		}))
+
+		r := httptest.NewRequest(http.MethodPost, "/links", nil)
+		r.RemoteAddr = tt.remoteAddr
+		r.Header.Set("X-Forwarded-User", "alice")
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		require.Equal(t, tt.role, got.Role, tt.name)
+	}
+}
@@ -0,0 +1,87 @@
+package http
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// ForwardedFor resolves the client IP of requests that reach the service
+// through trustedProxies: it is the right-most X-Forwarded-For hop that is
+// not a trusted proxy itself, as hops to its left can be set by the client.
+// Requests from other peers keep their own address.
+func ForwardedFor(trustedProxies []netip.Prefix, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := forwardedClientIP(r, trustedProxies)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+	})
+}
+
+// ClientIP returns the address of the client, as resolved by ForwardedFor,
+// or else the address of the peer.
+func ClientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return peerIP(r)
+}
+
+func peerIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func forwardedClientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	ip := peerIP(r)
+	if !trusted(trustedProxies, ip) {
+		return ip
+	}
+
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			// The hops further left were not written by a trusted proxy.
+			return ip
+		}
+		ip = addr.Unmap().String()
+		if !trusted(trustedProxies, ip) {
+			return ip
+		}
+	}
+	return ip
+}
+
+// trusted reports whether ip is in one of prefixes.
+func trusted(prefixes []netip.Prefix, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequestHost returns the lowercased host of the request without the port.
+func RequestHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host
+	}
+	return strings.ToLower(host)
+}
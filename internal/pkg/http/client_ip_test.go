@@ -0,0 +1,87 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardedFor(t *testing.T) {
+	proxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("fd00::/8")}
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		clientIP     string
+	}{
+		{
+			name:       "direct client",
+			remoteAddr: "192.0.2.1:1234",
+			clientIP:   "192.0.2.1",
+		},
+		{
+			name:         "direct client forging the header",
+			remoteAddr:   "192.0.2.1:1234",
+			forwardedFor: []string{"198.51.100.7"},
+			clientIP:     "192.0.2.1",
+		},
+		{
+			name:         "through a trusted proxy",
+			remoteAddr:   "10.0.0.2:4321",
+			forwardedFor: []string{"198.51.100.7"},
+			clientIP:     "198.51.100.7",
+		},
+		{
+			name:         "client prepending a forged hop",
+			remoteAddr:   "10.0.0.2:4321",
+			forwardedFor: []string{"203.0.113.9, 198.51.100.7"},
+			clientIP:     "198.51.100.7",
+		},
+		{
+			name:         "through a chain of trusted proxies",
+			remoteAddr:   "10.0.0.2:4321",
+			forwardedFor: []string{"203.0.113.9, 198.51.100.7", "10.0.0.3"},
+			clientIP:     "198.51.100.7",
+		},
+		{
+			name:         "ipv6",
+			remoteAddr:   "[fd00::2]:4321",
+			forwardedFor: []string{"2001:db8::7, fd00::3"},
+			clientIP:     "2001:db8::7",
+		},
+		{
+			name:         "only trusted hops",
+			remoteAddr:   "10.0.0.2:4321",
+			forwardedFor: []string{"10.0.0.3"},
+			clientIP:     "10.0.0.3",
+		},
+		{
+			name:         "malformed hop",
+			remoteAddr:   "10.0.0.2:4321",
+			forwardedFor: []string{"unknown, 10.0.0.3"},
+			clientIP:     "10.0.0.3",
+		},
+		{
+			name:       "trusted proxy without the header",
+			remoteAddr: "10.0.0.2:4321",
+			clientIP:   "10.0.0.2",
+		},
+	}
+	for _, tt := range tests {
+		var got string
+		handler := ForwardedFor(proxies, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = ClientIP(r)
+		}))
+
+		r := httptest.NewRequest(http.MethodGet, "/s/abc1234", nil)
+		r.RemoteAddr = tt.remoteAddr
+		for _, v := range tt.forwardedFor {
+			r.Header.Add("X-Forwarded-For", v)
+		}
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		require.Equal(t, tt.clientIP, got, tt.name)
+	}
+}
@@ -3,28 +3,74 @@ package http
 import (
 	"context"
 	"errors"
+	"log"
 	"net/http"
 
 	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
 )
 
 var (
-	ErrReadBody      = errors.New("read body error")
-	ErrJsonUnmarshal = errors.New("json unmarshal error")
+	ErrReadBody             = errors.New("read body error")
+	ErrJsonUnmarshal        = errors.New("json unmarshal error")
+	ErrUnknownField         = errors.New("unknown field error")
+	ErrTrailingData         = errors.New("trailing data after json value")
+	ErrFormParse            = errors.New("form parse error")
+	ErrBodyTooLarge         = errors.New("request body too large")
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	ErrCrossOriginForm      = errors.New("cross-origin form submission")
 )
 
 func HandleError(ctx context.Context, w http.ResponseWriter, err error) {
-	var errValidation *usecase.ErrValidation
+	WriteProblem(ctx, w, ProblemFromError(err))
+}
+
+func ProblemFromError(err error) Problem {
+	var problem Problem
+	var errValidation usecase.ErrValidation
 	switch {
 	case errors.As(err, &errValidation):
-		WriteJson(ctx, w, http.StatusBadRequest, J{"msg": errValidation.Error()})
+		problem = NewProblem(http.StatusBadRequest, CodeValidationFailed, errValidation.Error())
+		problem.Errors = fieldErrors(err)
+	case errors.Is(err, ErrBodyTooLarge):
+		problem = NewProblem(http.StatusRequestEntityTooLarge, CodeBodyTooLarge, ErrBodyTooLarge.Error())
+	case errors.Is(err, ErrUnsupportedMediaType):
+		problem = NewProblem(http.StatusUnsupportedMediaType, CodeUnsupportedMediaType, ErrUnsupportedMediaType.Error())
+	case errors.Is(err, ErrCrossOriginForm):
+		problem = NewProblem(http.StatusForbidden, CodeCrossOriginForm, ErrCrossOriginForm.Error())
 	case errors.Is(err, ErrReadBody):
-		WriteJson(ctx, w, http.StatusBadRequest, J{"msg": err.Error()})
+		problem = NewProblem(http.StatusBadRequest, CodeReadBodyFailed, err.Error())
 	case errors.Is(err, ErrJsonUnmarshal):
-		WriteJson(ctx, w, http.StatusBadRequest, J{"msg": err.Error()})
+		problem = NewProblem(http.StatusBadRequest, CodeInvalidJson, err.Error())
+	case errors.Is(err, ErrUnknownField):
+		problem = NewProblem(http.StatusBadRequest, CodeUnknownField, err.Error())
+	case errors.Is(err, ErrTrailingData):
+		problem = NewProblem(http.StatusBadRequest, CodeTrailingData, err.Error())
+	case errors.Is(err, ErrFormParse):
+		problem = NewProblem(http.StatusBadRequest, CodeInvalidForm, err.Error())
 	case errors.Is(err, usecase.ErrNoResult):
-		WriteJson(ctx, w, http.StatusNotFound, J{"msg": "not found"})
+		problem = NewProblem(http.StatusNotFound, CodeNotFound, "not found")
+	case errors.Is(err, usecase.ErrGone):
+		problem = NewProblem(http.StatusGone, CodeGone, "gone")
+	case errors.Is(err, usecase.ErrUnauthorized):
+		problem = NewProblem(http.StatusUnauthorized, CodeUnauthorized, "unauthorized")
+	case errors.Is(err, usecase.ErrForbidden):
+		problem = NewProblem(http.StatusForbidden, CodeForbidden, "forbidden")
+	case errors.Is(err, usecase.ErrConflict):
+		problem = NewProblem(http.StatusConflict, CodeConflict, "conflict")
+	case errors.Is(err, usecase.ErrTooManyRequests):
+		problem = NewProblem(http.StatusTooManyRequests, CodeTooManyRequests, "too many requests")
+	case errors.Is(err, usecase.ErrUnavailable):
+		problem = NewProblem(http.StatusServiceUnavailable, CodeUnavailable, "unavailable")
 	default:
-		WriteJson(ctx, w, http.StatusInternalServerError, J{"msg": err.Error()})
+		log.Printf("internal error: %v", err)
+		return NewProblem(http.StatusInternalServerError, CodeInternal, "internal error")
+	}
+
+	var coded *usecase.Error
+	if errors.As(err, &coded) {
+		problem.Type = "urn:problem:" + coded.Code()
+		problem.Code = coded.Code()
+		problem.Detail = coded.Message()
 	}
+	return problem
 }
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+type variantData struct {
+	Name string `validate:"required,alphanum"`
+}
+
+type createData struct {
+	Href      string        `validate:"required,http_url"`
+	WebhookID int64         `validate:"min=1"`
+	Variants  []variantData `validate:"dive"`
+}
+
+func TestHandleErrorValidation(t *testing.T) {
+	err := validator.New().Struct(createData{WebhookID: 0, Variants: []variantData{{Name: "a"}, {Name: ""}}})
+	require.Error(t, err)
+
+	r := httptest.NewRequest(http.MethodPost, "/new", nil)
+	w := httptest.NewRecorder()
+	WithInstance(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		HandleError(r.Context(), w, usecase.NewErrValidation("Invalid request", err))
+	})).ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, ProblemContentType, w.Header().Get("content-type"))
+
+	var problem Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	require.Equal(t, Problem{
+		Type:     "urn:problem:validation_failed",
+		Title:    "Bad Request",
+		Status:   http.StatusBadRequest,
+		Detail:   "Invalid request",
+		Instance: "/new",
+		Code:     CodeValidationFailed,
+		Errors: []FieldError{
+			{Field: "href", Code: "required", Detail: "is required"},
+			{Field: "webhookId", Code: "min", Param: "1", Detail: "must be at least 1"},
+			{Field: "variants[1].name", Code: "required", Detail: "is required"},
+		},
+	}, problem)
+}
+
+func TestHandleErrorCodes(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{err: errors.Join(ErrJsonUnmarshal, errors.New("eof")), status: http.StatusBadRequest, code: CodeInvalidJson},
+		{err: ErrUnsupportedMediaType, status: http.StatusUnsupportedMediaType, code: CodeUnsupportedMediaType},
+		{err: errors.Join(ErrBodyTooLarge, &http.MaxBytesError{Limit: 8}), status: http.StatusRequestEntityTooLarge, code: CodeBodyTooLarge},
+		{err: ErrTrailingData, status: http.StatusBadRequest, code: CodeTrailingData},
+		{err: usecase.ErrNoResult, status: http.StatusNotFound, code: CodeNotFound},
+		{err: usecase.NewError(usecase.ErrGone, "link_exhausted", "link click limit reached"), status: http.StatusGone, code: "link_exhausted"},
+		{err: usecase.ErrUnauthorized, status: http.StatusUnauthorized, code: CodeUnauthorized},
+		{err: usecase.ErrForbidden, status: http.StatusForbidden, code: CodeForbidden},
+		{err: usecase.NewError(usecase.ErrConflict, "domain_taken", "domain is used by another workspace"), status: http.StatusConflict, code: "domain_taken"},
+		{err: usecase.ErrTooManyRequests, status: http.StatusTooManyRequests, code: CodeTooManyRequests},
+		{err: errors.New("connection refused"), status: http.StatusInternalServerError, code: CodeInternal},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		HandleError(context.Background(), w, tt.err)
+
+		var problem Problem
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+		require.Equal(t, tt.status, w.Code, tt.err.Error())
+		require.Equal(t, tt.code, problem.Code, tt.err.Error())
+		require.Equal(t, "urn:problem:"+tt.code, problem.Type)
+		require.NotContains(t, problem.Detail, "connection refused")
+	}
+}
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldErrorer is implemented by errors that know which request fields
+// were invalid.
+type FieldErrorer interface {
+	FieldErrors() []FieldError
+}
+
+func fieldErrors(err error) []FieldError {
+	var fe FieldErrorer
+	if errors.As(err, &fe) {
+		return fe.FieldErrors()
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+	result := make([]FieldError, 0, len(validationErrors))
+	for _, e := range validationErrors {
+		result = append(result, FieldError{
+			Field:  fieldName(e.Namespace()),
+			Code:   e.Tag(),
+			Param:  e.Param(),
+			Detail: fieldErrorDetail(e),
+		})
+	}
+	return result
+}
+
+// fieldName turns "CreateLinkData.Variants[0].Name" into "variants[0].name".
+func fieldName(namespace string) string {
+	parts := strings.Split(namespace, ".")
+	if len(parts) > 1 {
+		parts = parts[1:]
+	}
+	for i, part := range parts {
+		name, index, _ := strings.Cut(part, "[")
+		parts[i] = lowerCamel(name)
+		if index != "" {
+			parts[i] += "[" + index
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func lowerCamel(name string) string {
+	if base, ok := strings.CutSuffix(name, "ID"); ok {
+		name = base + "Id"
+	}
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
+		}
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
+		}
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+func fieldErrorDetail(e validator.FieldError) string {
+	switch e.Tag() {
+	case "required":
+		return "is required"
+	case "min":
+		return fmt.Sprintf("must be at least %s", e.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", e.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", e.Param())
+	case "http_url", "url":
+		return "must be a valid URL"
+	case "unique":
+		return "must be unique"
+	default:
+		return fmt.Sprintf("failed on %q", e.Tag())
+	}
+}
@@ -0,0 +1,128 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ReadForm decodes an application/x-www-form-urlencoded body into T using
+// the json tags of its fields. Nested structs and string maps use dotted keys
+// ("queryOptions.conflict", "metadata.campaign") and string slices repeated
+// keys; slices of structs cannot be expressed in a form and are rejected.
+//
+// Browsers post forms to other origins without a CORS preflight, so forms
+// from another site are rejected with ErrCrossOriginForm: otherwise a page
+// elsewhere could act with the visitor's cookies or proxy login.
+func ReadForm[T any](ctx context.Context, r *http.Request) (T, error) {
+	var zero T
+	if mediaType(r) != "application/x-www-form-urlencoded" {
+		return zero, ErrUnsupportedMediaType
+	}
+	if !sameOrigin(r) {
+		return zero, ErrCrossOriginForm
+	}
+
+	body, err := ReadBody(r)
+	if err != nil {
+		return zero, err
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return zero, errors.Join(ErrFormParse, err)
+	}
+
+	var result T
+	for key, vals := range values {
+		if err := setFormValue(reflect.ValueOf(&result).Elem(), strings.Split(key, "."), vals); err != nil {
+			return zero, err
+		}
+	}
+	return result, nil
+}
+
+// sameOrigin reports whether a browser sent r from a page of its own origin,
+// by Sec-Fetch-Site or else by Origin. Requests with neither header do not
+// come from a browser and cannot carry a visitor's credentials unwittingly.
+func sameOrigin(r *http.Request) bool {
+	if site := r.Header.Get("Sec-Fetch-Site"); site != "" {
+		return site == "same-origin" || site == "none"
+	}
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+func setFormValue(v reflect.Value, path []string, vals []string) error {
+	field, ok := fieldByJsonName(v, path[0])
+	if !ok {
+		return errors.Join(ErrUnknownField, fmt.Errorf("unknown field %q", strings.Join(path, ".")))
+	}
+	if field.Kind() == reflect.Pointer {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field = field.Elem()
+	}
+
+	if len(path) > 1 && field.Kind() == reflect.Map && field.Type().Elem().Kind() == reflect.String {
+		if field.IsNil() {
+			field.Set(reflect.MakeMap(field.Type()))
+		}
+		field.SetMapIndex(reflect.ValueOf(strings.Join(path[1:], ".")), reflect.ValueOf(vals[len(vals)-1]))
+		return nil
+	}
+	if len(path) > 1 {
+		if field.Kind() != reflect.Struct {
+			return errors.Join(ErrUnknownField, fmt.Errorf("unknown field %q", strings.Join(path, ".")))
+		}
+		return setFormValue(field, path[1:], vals)
+	}
+
+	var err error
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(vals[len(vals)-1])
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(vals[len(vals)-1])
+		field.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		var n int64
+		n, err = strconv.ParseInt(vals[len(vals)-1], 10, field.Type().Bits())
+		field.SetInt(n)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return errors.Join(ErrFormParse, fmt.Errorf("field %q is not supported in forms", path[0]))
+		}
+		field.Set(reflect.ValueOf(append([]string(nil), vals...)))
+	default:
+		return errors.Join(ErrFormParse, fmt.Errorf("field %q is not supported in forms", path[0]))
+	}
+	if err != nil {
+		return errors.Join(ErrFormParse, fmt.Errorf("field %q: %w", path[0], err))
+	}
+	return nil
+}
+
+func fieldByJsonName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if tag == name && t.Field(i).IsExported() {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
@@ -1,27 +1,86 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"mime"
 	"net/http"
+	"strings"
 )
 
+// ReadJson decodes an application/json body into T. Unknown fields and
+// anything after the first JSON value are rejected.
 func ReadJson[T any](ctx context.Context, r *http.Request) (T, error) {
 	var zero T
-	body, err := io.ReadAll(r.Body)
+	if mediaType(r) != "application/json" {
+		return zero, ErrUnsupportedMediaType
+	}
+
+	body, err := ReadBody(r)
 	if err != nil {
-		return zero, errors.Join(ErrReadBody, err)
+		return zero, err
 	}
 
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+
 	var result T
-	if err := json.Unmarshal(body, &result); err != nil {
+	if err := dec.Decode(&result); err != nil {
+		if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+			return zero, errors.Join(ErrUnknownField, fmt.Errorf("unknown field %s", field))
+		}
 		return zero, errors.Join(ErrJsonUnmarshal, err)
 	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return zero, ErrTrailingData
+	}
 	return result, nil
 }
 
+// ReadJsonOrForm accepts either an application/json or an
+// application/x-www-form-urlencoded body, see ReadForm.
+func ReadJsonOrForm[T any](ctx context.Context, r *http.Request) (T, error) {
+	if mediaType(r) == "application/x-www-form-urlencoded" {
+		return ReadForm[T](ctx, r)
+	}
+	return ReadJson[T](ctx, r)
+}
+
+// ReadBody reads the whole request body. Bodies over the LimitBody limit
+// fail with ErrBodyTooLarge.
+func ReadBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, errors.Join(ErrBodyTooLarge, err)
+		}
+		return nil, errors.Join(ErrReadBody, err)
+	}
+	return body, nil
+}
+
+// LimitBody caps request bodies at maxBytes.
+func LimitBody(maxBytes int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > maxBytes {
+			HandleError(r.Context(), w, ErrBodyTooLarge)
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func mediaType(r *http.Request) string {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("content-type"))
+	return mediaType
+}
+
 func WriteJson[T any](ctx context.Context, w http.ResponseWriter, status int, content T) {
 	w.Header().Set("content-type", "application/json")
 	w.WriteHeader(status)
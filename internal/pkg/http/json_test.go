@@ -0,0 +1,191 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type queryInput struct {
+	Passthrough *bool    `json:"passthrough"`
+	Allowlist   []string `json:"allowlist"`
+}
+
+type linkInput struct {
+	Href         string            `json:"href"`
+	MaxClicks    *int32            `json:"maxClicks"`
+	QueryOptions *queryInput       `json:"queryOptions"`
+	Metadata     map[string]string `json:"metadata"`
+}
+
+func TestReadJsonOrForm(t *testing.T) {
+	maxClicks := int32(3)
+	passthrough := true
+
+	tests := []struct {
+		name        string
+		contentType string
+		headers     map[string]string
+		body        string
+		exp         linkInput
+		err         error
+	}{
+		{
+			name:        "json",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"href":"https://site","maxClicks":3}`,
+			exp:         linkInput{Href: "https://site", MaxClicks: &maxClicks},
+		},
+		{
+			name:        "json unknown field",
+			contentType: "application/json",
+			body:        `{"href":"https://site","hreff":"x"}`,
+			err:         ErrUnknownField,
+		},
+		{
+			name:        "json trailing data",
+			contentType: "application/json",
+			body:        `{"href":"https://site"}{}`,
+			err:         ErrTrailingData,
+		},
+		{
+			name:        "json malformed",
+			contentType: "application/json",
+			body:        `{"href":`,
+			err:         ErrJsonUnmarshal,
+		},
+		{
+			name:        "missing content type",
+			contentType: "",
+			body:        `{"href":"https://site"}`,
+			err:         ErrUnsupportedMediaType,
+		},
+		{
+			name:        "text",
+			contentType: "text/plain",
+			body:        "https://site",
+			err:         ErrUnsupportedMediaType,
+		},
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "href=https%3A%2F%2Fsite&maxClicks=3&queryOptions.passthrough=true&queryOptions.allowlist=utm_*&queryOptions.allowlist=ref",
+			exp: linkInput{
+				Href:         "https://site",
+				MaxClicks:    &maxClicks,
+				QueryOptions: &queryInput{Passthrough: &passthrough, Allowlist: []string{"utm_*", "ref"}},
+			},
+		},
+		{
+			name:        "form map",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "href=https%3A%2F%2Fsite&metadata.campaign=spring&metadata.utm.source=mail",
+			exp: linkInput{
+				Href:     "https://site",
+				Metadata: map[string]string{"campaign": "spring", "utm.source": "mail"},
+			},
+		},
+		{
+			name:        "form from the same origin",
+			contentType: "application/x-www-form-urlencoded",
+			headers:     map[string]string{"Origin": "http://example.com", "Sec-Fetch-Site": "same-origin"},
+			body:        "href=https%3A%2F%2Fsite",
+			exp:         linkInput{Href: "https://site"},
+		},
+		{
+			name:        "form from the same origin without fetch metadata",
+			contentType: "application/x-www-form-urlencoded",
+			headers:     map[string]string{"Origin": "http://EXAMPLE.com"},
+			body:        "href=https%3A%2F%2Fsite",
+			exp:         linkInput{Href: "https://site"},
+		},
+		{
+			name:        "form from another site",
+			contentType: "application/x-www-form-urlencoded",
+			headers:     map[string]string{"Origin": "https://attacker.test", "Sec-Fetch-Site": "cross-site"},
+			body:        "href=https%3A%2F%2Fsite",
+			err:         ErrCrossOriginForm,
+		},
+		{
+			name:        "form from a sibling subdomain",
+			contentType: "application/x-www-form-urlencoded",
+			headers:     map[string]string{"Origin": "http://evil.example.com", "Sec-Fetch-Site": "same-site"},
+			body:        "href=https%3A%2F%2Fsite",
+			err:         ErrCrossOriginForm,
+		},
+		{
+			name:        "form from another origin without fetch metadata",
+			contentType: "application/x-www-form-urlencoded",
+			headers:     map[string]string{"Origin": "https://attacker.test"},
+			body:        "href=https%3A%2F%2Fsite",
+			err:         ErrCrossOriginForm,
+		},
+		{
+			name:        "form with an opaque origin",
+			contentType: "application/x-www-form-urlencoded",
+			headers:     map[string]string{"Origin": "null"},
+			body:        "href=https%3A%2F%2Fsite",
+			err:         ErrCrossOriginForm,
+		},
+		{
+			name:        "form unknown field",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "href=https%3A%2F%2Fsite&queryOptions.unknown=1",
+			err:         ErrUnknownField,
+		},
+		{
+			name:        "form invalid number",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "maxClicks=many",
+			err:         ErrFormParse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := require.New(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/new", strings.NewReader(tt.body))
+			req.Header.Set("content-type", tt.contentType)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			input, err := ReadJsonOrForm[linkInput](context.Background(), req)
+
+			if tt.err != nil {
+				r.ErrorIs(err, tt.err)
+				return
+			}
+			r.NoError(err)
+			r.Equal(tt.exp, input)
+		})
+	}
+}
+
+func TestLimitBody(t *testing.T) {
+	handler := LimitBody(8, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := ReadJson[map[string]any](r.Context(), r); err != nil {
+			HandleError(r.Context(), w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for body, status := range map[string]int{
+		`{}`:                   http.StatusNoContent,
+		`{"href":"https://x"}`: http.StatusRequestEntityTooLarge,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/new", strings.NewReader(body))
+		req.Header.Set("content-type", "application/json")
+		req.ContentLength = -1
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		require.Equal(t, status, w.Code, body)
+	}
+}
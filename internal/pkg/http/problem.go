@@ -0,0 +1,83 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const ProblemContentType = "application/problem+json"
+
+const (
+	CodeValidationFailed     = "validation_failed"
+	CodeReadBodyFailed       = "read_body_failed"
+	CodeInvalidJson          = "invalid_json"
+	CodeUnknownField         = "unknown_field"
+	CodeTrailingData         = "trailing_data"
+	CodeInvalidForm          = "invalid_form"
+	CodeBodyTooLarge         = "body_too_large"
+	CodeUnsupportedMediaType = "unsupported_media_type"
+	CodeCrossOriginForm      = "cross_origin_form"
+	CodeNotFound             = "not_found"
+	CodeGone                 = "gone"
+	CodeUnauthorized         = "unauthorized"
+	CodeForbidden            = "forbidden"
+	CodeConflict             = "conflict"
+	CodeTooManyRequests      = "too_many_requests"
+	CodeUnavailable          = "unavailable"
+	CodeInternal             = "internal"
+)
+
+// Problem is an RFC 7807 problem details object extended with a stable
+// code and per-field errors.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     string       `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+type FieldError struct {
+	Field  string `json:"field"`
+	Code   string `json:"code"`
+	Param  string `json:"param,omitempty"`
+	Detail string `json:"detail"`
+}
+
+func NewProblem(status int, code string, detail string) Problem {
+	return Problem{
+		Type:   "urn:problem:" + code,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+func WriteProblem(ctx context.Context, w http.ResponseWriter, problem Problem) {
+	if problem.Instance == "" {
+		problem.Instance = instanceFromContext(ctx)
+	}
+	w.Header().Set("content-type", ProblemContentType)
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+type instanceKey struct{}
+
+// WithInstance stores the request path in the context so that problems
+// written by HandleError carry it as "instance".
+func WithInstance(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), instanceKey{}, r.URL.Path)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func instanceFromContext(ctx context.Context) string {
+	instance, _ := ctx.Value(instanceKey{}).(string)
+	return instance
+}
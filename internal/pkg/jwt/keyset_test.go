@@ -0,0 +1,80 @@
+package jwt
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type jwksServer struct {
+	mu       sync.Mutex
+	body     []byte
+	status   int
+	requests int
+}
+
+func (s *jwksServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(s.body)
+}
+
+func (s *jwksServer) set(body []byte, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.body = body
+	s.status = status
+}
+
+func TestKeySetRotation(t *testing.T) {
+	rsaKey, ecKey, _ := newTestKeys(t)
+	server := &jwksServer{body: keySetJSON(t, rsaKey)}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	keys := NewKeySet(KeySetParams{Source: ts.URL, RequestTimeout: time.Second, MinRefreshInterval: time.Hour})
+	ctx := context.Background()
+
+	_, err := keys.Key(ctx, "rsa")
+	require.NoError(t, err)
+	_, err = keys.Key(ctx, "rsa")
+	require.NoError(t, err)
+	require.Equal(t, 1, server.requests, "keys are cached")
+
+	server.set(keySetJSON(t, rsaKey, ecKey), 0)
+	_, err = keys.Key(ctx, "ec")
+	require.ErrorIs(t, err, ErrInvalidToken, "unknown keys reload the set at most once per interval")
+	require.Equal(t, 1, server.requests)
+
+	keys.minRefreshInterval = 0
+	_, err = keys.Key(ctx, "ec")
+	require.NoError(t, err, "an unknown key reloads the set")
+	require.Equal(t, 2, server.requests)
+
+	server.set(nil, http.StatusServiceUnavailable)
+	require.Error(t, keys.Refresh(ctx))
+	_, err = keys.Key(ctx, "rsa")
+	require.NoError(t, err, "cached keys outlive a failed refresh")
+}
+
+func TestKeySetNotLoaded(t *testing.T) {
+	server := &jwksServer{status: http.StatusInternalServerError}
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	keys := NewKeySet(KeySetParams{Source: ts.URL, RequestTimeout: time.Second, MinRefreshInterval: time.Hour})
+	_, err := keys.Key(context.Background(), "rsa")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrInvalidToken, "an unavailable key set is not the token's fault")
+}
@@ -0,0 +1,263 @@
+package jwt
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+var ErrInvalidToken = fmt.Errorf("invalid token: %w", usecase.ErrUnauthorized)
+
+type algorithm struct {
+	hash crypto.Hash
+	// verify checks the signature of digest, the hashed signing input.
+	verify func(key crypto.PublicKey, digest, signature []byte) bool
+}
+
+// algorithms are the JWS algorithms accepted. Symmetric ones are not, as
+// the key set only holds public keys, and neither is "none".
+var algorithms = map[string]algorithm{
+	"RS256": {crypto.SHA256, verifyPKCS1v15(crypto.SHA256)},
+	"RS384": {crypto.SHA384, verifyPKCS1v15(crypto.SHA384)},
+	"RS512": {crypto.SHA512, verifyPKCS1v15(crypto.SHA512)},
+	"PS256": {crypto.SHA256, verifyPSS(crypto.SHA256)},
+	"PS384": {crypto.SHA384, verifyPSS(crypto.SHA384)},
+	"PS512": {crypto.SHA512, verifyPSS(crypto.SHA512)},
+	"ES256": {crypto.SHA256, verifyECDSA(256)},
+	"ES384": {crypto.SHA384, verifyECDSA(384)},
+	"ES512": {crypto.SHA512, verifyECDSA(521)},
+	"EdDSA": {0, verifyEd25519},
+}
+
+// Verifier checks JWTs signed by a key of its KeySet and maps their claims
+// to an identity: the user from UserClaim and the roles from RolesClaim.
+// Claim names may be dotted to reach nested claims, e.g. realm_access.roles.
+type Verifier struct {
+	keys       *KeySet
+	issuer     string
+	audience   string
+	leeway     time.Duration
+	userClaim  string
+	rolesClaim string
+	now        func() time.Time
+}
+
+type VerifierParams struct {
+	Keys *KeySet
+	// Issuer and Audience, when set, must match the iss and aud claims.
+	Issuer     string
+	Audience   string
+	Leeway     time.Duration
+	UserClaim  string
+	RolesClaim string
+}
+
+func NewVerifier(params VerifierParams) *Verifier {
+	return &Verifier{
+		keys:       params.Keys,
+		issuer:     params.Issuer,
+		audience:   params.Audience,
+		leeway:     params.Leeway,
+		userClaim:  params.UserClaim,
+		rolesClaim: params.RolesClaim,
+		now:        time.Now,
+	}
+}
+
+func (v *Verifier) VerifyToken(ctx context.Context, token string) (usecase.Identity, error) {
+	claims, err := v.verify(ctx, token)
+	if err != nil {
+		return usecase.Identity{}, err
+	}
+	if err := v.validate(claims); err != nil {
+		return usecase.Identity{}, err
+	}
+
+	user, _ := claim(claims, v.userClaim).(string)
+	if user == "" {
+		return usecase.Identity{}, fmt.Errorf("%w: no %s claim", ErrInvalidToken, v.userClaim)
+	}
+	return usecase.Identity{User: user, Roles: roles(claim(claims, v.rolesClaim))}, nil
+}
+
+// verify checks the signature of token and returns its claims.
+func (v *Verifier) verify(ctx context.Context, token string) (map[string]any, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %w", ErrInvalidToken, err)
+	}
+	alg, ok := algorithms[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("%w: algorithm %q not allowed", ErrInvalidToken, header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %w", ErrInvalidToken, err)
+	}
+
+	key, err := v.keys.Key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+	digest := signed
+	if alg.hash != 0 {
+		h := alg.hash.New()
+		h.Write(signed)
+		digest = h.Sum(nil)
+	}
+	if !alg.verify(key, digest, signature) {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidToken)
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: claims: %w", ErrInvalidToken, err)
+	}
+	return claims, nil
+}
+
+// validate checks the registered claims; exp is required.
+func (v *Verifier) validate(claims map[string]any) error {
+	now := v.now()
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return fmt.Errorf("%w: no exp claim", ErrInvalidToken)
+	}
+	if !now.Before(exp.Add(v.leeway)) {
+		return fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(v.leeway).Before(nbf) {
+		return fmt.Errorf("%w: not valid yet", ErrInvalidToken)
+	}
+	if v.issuer != "" && claims["iss"] != v.issuer {
+		return fmt.Errorf("%w: issuer %v not accepted", ErrInvalidToken, claims["iss"])
+	}
+	if v.audience != "" && !contains(claims["aud"], v.audience) {
+		return fmt.Errorf("%w: audience %v not accepted", ErrInvalidToken, claims["aud"])
+	}
+	return nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// claim returns the value at a dotted path of claims, nil when absent.
+func claim(claims map[string]any, path string) any {
+	var value any = claims
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+// roles reads a list of strings or a space separated string, as in scope.
+func roles(value any) []string {
+	switch value := value.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		roles := make([]string, 0, len(value))
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				roles = append(roles, s)
+			}
+		}
+		return roles
+	default:
+		return nil
+	}
+}
+
+func numericDate(value any) (time.Time, bool) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, 0).Add(time.Duration(f * float64(time.Second))), true
+}
+
+// contains reports whether aud, a string or a list of strings, has want.
+func contains(aud any, want string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == want
+	case []any:
+		for _, v := range aud {
+			if v == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func verifyPKCS1v15(hash crypto.Hash) func(crypto.PublicKey, []byte, []byte) bool {
+	return func(key crypto.PublicKey, digest, signature []byte) bool {
+		rsaKey, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature) == nil
+	}
+}
+
+func verifyPSS(hash crypto.Hash) func(crypto.PublicKey, []byte, []byte) bool {
+	return func(key crypto.PublicKey, digest, signature []byte) bool {
+		rsaKey, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPSS(rsaKey, hash, digest, signature, nil) == nil
+	}
+}
+
+// verifyECDSA checks a JWS ECDSA signature, r and s concatenated, on a
+// curve of bitSize bits.
+func verifyECDSA(bitSize int) func(crypto.PublicKey, []byte, []byte) bool {
+	size := (bitSize + 7) / 8
+	return func(key crypto.PublicKey, digest, signature []byte) bool {
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || ecKey.Curve.Params().BitSize != bitSize || len(signature) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		return ecdsa.Verify(ecKey, digest, r, s)
+	}
+}
+
+func verifyEd25519(key crypto.PublicKey, message, signature []byte) bool {
+	edKey, ok := key.(ed25519.PublicKey)
+	return ok && ed25519.Verify(edKey, message, signature)
+}
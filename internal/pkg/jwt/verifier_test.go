@@ -0,0 +1,179 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+type testKey struct {
+	kid     string
+	alg     string
+	private crypto.Signer
+}
+
+func newTestKeys(t *testing.T) (rsaKey, ecKey, edKey testKey) {
+	t.Helper()
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecPrivate, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return testKey{kid: "rsa", alg: "RS256", private: rsaPrivate},
+		testKey{kid: "ec", alg: "ES256", private: ecPrivate},
+		testKey{kid: "ed", alg: "EdDSA", private: edPrivate}
+}
+
+// keySetJSON is the JWKS of the public halves of keys.
+func keySetJSON(t *testing.T, keys ...testKey) []byte {
+	t.Helper()
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	jwks := make([]map[string]string, 0, len(keys))
+	for _, k := range keys {
+		jwk := map[string]string{"kid": k.kid, "use": "sig"}
+		switch pub := k.private.Public().(type) {
+		case *rsa.PublicKey:
+			jwk["kty"] = "RSA"
+			jwk["n"] = encode(pub.N.Bytes())
+			jwk["e"] = encode(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			jwk["kty"] = "EC"
+			jwk["crv"] = "P-256"
+			jwk["x"] = encode(pub.X.FillBytes(make([]byte, 32)))
+			jwk["y"] = encode(pub.Y.FillBytes(make([]byte, 32)))
+		case ed25519.PublicKey:
+			jwk["kty"] = "OKP"
+			jwk["crv"] = "Ed25519"
+			jwk["x"] = encode(pub)
+		}
+		jwks = append(jwks, jwk)
+	}
+	data, err := json.Marshal(map[string]any{"keys": jwks})
+	require.NoError(t, err)
+	return data
+}
+
+func writeKeySet(t *testing.T, keys ...testKey) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, keySetJSON(t, keys...), 0o600))
+	return path
+}
+
+func sign(t *testing.T, key testKey, claims map[string]any) string {
+	t.Helper()
+	encode := func(v any) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signed := encode(map[string]string{"alg": key.alg, "kid": key.kid, "typ": "JWT"}) + "." + encode(claims)
+
+	var signature []byte
+	var err error
+	digest := sha256.Sum256([]byte(signed))
+	switch private := key.private.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, private, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, private, digest[:])
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(private, []byte(signed))
+	}
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerifyToken(t *testing.T) {
+	rsaKey, ecKey, edKey := newTestKeys(t)
+	_, _, untrusted := newTestKeys(t)
+	untrusted.kid = edKey.kid
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	verifier := NewVerifier(VerifierParams{
+		Keys:       NewKeySet(KeySetParams{Source: writeKeySet(t, rsaKey, ecKey, edKey)}),
+		Issuer:     "https://sso.example.com",
+		Audience:   "shortener",
+		Leeway:     time.Minute,
+		UserClaim:  "preferred_username",
+		RolesClaim: "realm_access.roles",
+	})
+	verifier.now = func() time.Time { return now }
+
+	claims := func(overrides map[string]any) map[string]any {
+		c := map[string]any{
+			"iss":                "https://sso.example.com",
+			"aud":                []string{"account", "shortener"},
+			"sub":                "f81d4fae",
+			"preferred_username": "alice",
+			"realm_access":       map[string]any{"roles": []string{"editor", "acme:admin"}},
+			"exp":                now.Add(time.Hour).Unix(),
+			"nbf":                now.Add(-time.Hour).Unix(),
+		}
+		for k, v := range overrides {
+			if v == nil {
+				delete(c, k)
+				continue
+			}
+			c[k] = v
+		}
+		return c
+	}
+	alice := usecase.Identity{User: "alice", Roles: []string{"editor", "acme:admin"}}
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(`{"preferred_username":"alice"}`)) + "."
+
+	tests := []struct {
+		name     string
+		token    string
+		identity usecase.Identity
+		err      error
+	}{
+		{name: "RS256", token: sign(t, rsaKey, claims(nil)), identity: alice},
+		{name: "ES256", token: sign(t, ecKey, claims(nil)), identity: alice},
+		{name: "EdDSA", token: sign(t, edKey, claims(nil)), identity: alice},
+		{
+			name:     "audience string and no roles",
+			token:    sign(t, rsaKey, claims(map[string]any{"aud": "shortener", "realm_access": nil})),
+			identity: usecase.Identity{User: "alice"},
+		},
+		{name: "expired within leeway", token: sign(t, rsaKey, claims(map[string]any{"exp": now.Add(-30 * time.Second).Unix()})), identity: alice},
+		{name: "expired", token: sign(t, rsaKey, claims(map[string]any{"exp": now.Add(-2 * time.Minute).Unix()})), err: ErrInvalidToken},
+		{name: "no exp", token: sign(t, rsaKey, claims(map[string]any{"exp": nil})), err: ErrInvalidToken},
+		{name: "not valid yet", token: sign(t, rsaKey, claims(map[string]any{"nbf": now.Add(2 * time.Minute).Unix()})), err: ErrInvalidToken},
+		{name: "other issuer", token: sign(t, rsaKey, claims(map[string]any{"iss": "https://evil.example.com"})), err: ErrInvalidToken},
+		{name: "other audience", token: sign(t, rsaKey, claims(map[string]any{"aud": "account"})), err: ErrInvalidToken},
+		{name: "no user", token: sign(t, rsaKey, claims(map[string]any{"preferred_username": nil})), err: ErrInvalidToken},
+		{name: "untrusted key", token: sign(t, untrusted, claims(nil)), err: ErrInvalidToken},
+		{name: "unknown key", token: sign(t, testKey{kid: "other", alg: "RS256", private: rsaKey.private}, claims(nil)), err: ErrInvalidToken},
+		{name: "algorithm of another key type", token: sign(t, testKey{kid: "ec", alg: "RS256", private: rsaKey.private}, claims(nil)), err: ErrInvalidToken},
+		{name: "alg none", token: none, err: ErrInvalidToken},
+		{name: "malformed", token: "not.a-token", err: ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		identity, err := verifier.VerifyToken(context.Background(), tt.token)
+		require.ErrorIs(t, err, tt.err, tt.name)
+		if tt.err != nil {
+			require.ErrorIs(t, err, usecase.ErrUnauthorized, tt.name)
+		}
+		require.Equal(t, tt.identity, identity, tt.name)
+	}
+}
@@ -0,0 +1,25 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	workspaces_usecase "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+// AddWorkspaceDomain returns usecase.ErrNoResult when another workspace owns
+// the domain.
+func (r *Repo) AddWorkspaceDomain(ctx context.Context, args workspaces_usecase.WorkspaceDomain) (entity.Domain, error) {
+	row, err := r.q.AddWorkspaceDomain(ctx, sqlc.AddWorkspaceDomainParams(args))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Domain{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.Domain{}, err
+	}
+	return toEntityDomain(row), nil
+}
@@ -0,0 +1,35 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) ClaimWebhookDeliveries(ctx context.Context, args usecase.ClaimWebhookDeliveriesArgs) ([]entity.DeliveryJob, error) {
+	p := sqlc.ClaimWebhookDeliveriesParams{
+		LeaseUntil: args.LeaseUntil,
+		BatchSize:  args.BatchSize,
+	}
+	rows, err := r.q.ClaimWebhookDeliveries(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]entity.DeliveryJob, 0, len(rows))
+	for _, row := range rows {
+		jobs = append(jobs, entity.DeliveryJob{
+			ID:             row.ID,
+			Attempts:       int(row.Attempts),
+			URL:            row.Url,
+			Secret:         row.Secret,
+			EventID:        row.EventID,
+			EventType:      row.Type,
+			Payload:        row.Payload,
+			EventCreatedAt: row.CreatedAt,
+		})
+	}
+	return jobs, nil
+}
@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+func (r *Repo) CountLinkClicksByVariant(ctx context.Context, linkID int64) ([]entity.VariantStats, error) {
+	rows, err := r.q.CountLinkClicksByVariant(ctx, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]entity.VariantStats, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, entity.VariantStats{
+			Variant: row.Variant.String,
+			Clicks:  row.Count,
+		})
+	}
+	return stats, nil
+}
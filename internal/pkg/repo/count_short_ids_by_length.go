@@ -0,0 +1,11 @@
+package repo
+
+import "context"
+
+func (r *Repo) CountShortIDsByLength(ctx context.Context, length int) (int64, error) {
+	count, err := r.q.CountShortIDsByLength(ctx, int32(length))
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
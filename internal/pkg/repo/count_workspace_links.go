@@ -0,0 +1,7 @@
+package repo
+
+import "context"
+
+func (r *Repo) CountWorkspaceLinks(ctx context.Context, workspaceID int64) (int64, error) {
+	return r.q.CountWorkspaceLinks(ctx, workspaceID)
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateAPIKey(ctx context.Context, args usecase.CreateAPIKeyArgs) (entity.APIKey, error) {
+	k, err := r.q.CreateAPIKey(ctx, sqlc.CreateAPIKeyParams(args))
+	if err != nil {
+		return entity.APIKey{}, err
+	}
+	return toEntityAPIKey(k), nil
+}
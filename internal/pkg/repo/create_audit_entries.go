@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateAuditEntries(ctx context.Context, args []usecase.CreateAuditEntryArgs) error {
+	p := make([]sqlc.CreateAuditEntriesParams, 0, len(args))
+	for _, a := range args {
+		entry, err := toCreateAuditEntryParams(a)
+		if err != nil {
+			return err
+		}
+		p = append(p, sqlc.CreateAuditEntriesParams(entry))
+	}
+	return execBatch(r.q.CreateAuditEntries(ctx, p).Exec)
+}
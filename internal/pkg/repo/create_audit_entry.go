@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateAuditEntry(ctx context.Context, args usecase.CreateAuditEntryArgs) error {
+	p, err := toCreateAuditEntryParams(args)
+	if err != nil {
+		return err
+	}
+	return r.q.CreateAuditEntry(ctx, p)
+}
+
+func toCreateAuditEntryParams(args usecase.CreateAuditEntryArgs) (sqlc.CreateAuditEntryParams, error) {
+	oldValue, err := marshalAuditValue(args.OldValue)
+	if err != nil {
+		return sqlc.CreateAuditEntryParams{}, err
+	}
+	newValue, err := marshalAuditValue(args.NewValue)
+	if err != nil {
+		return sqlc.CreateAuditEntryParams{}, err
+	}
+	return sqlc.CreateAuditEntryParams{
+		LinkID:      args.LinkID,
+		ShortID:     args.ShortID,
+		Action:      args.Action,
+		ActorType:   args.Actor.Type,
+		ActorID:     args.Actor.ID,
+		OldValue:    oldValue,
+		NewValue:    newValue,
+		WorkspaceID: args.WorkspaceID,
+	}, nil
+}
+
+// marshalAuditValue stores a missing value as NULL rather than JSON null.
+func marshalAuditValue(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return b, nil
+}
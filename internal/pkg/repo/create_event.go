@@ -0,0 +1,25 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateEvent(ctx context.Context, args usecase.CreateEventArgs) error {
+	payload, err := json.Marshal(args.Payload)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	p := sqlc.CreateEventParams{
+		Type:        args.Type,
+		LinkID:      args.LinkID,
+		Payload:     payload,
+		WorkspaceID: args.WorkspaceID,
+	}
+	return r.q.CreateEvent(ctx, p)
+}
@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateEvents(ctx context.Context, args []usecase.CreateEventArgs) error {
+	p := make([]sqlc.CreateEventsParams, 0, len(args))
+	for _, a := range args {
+		payload, err := json.Marshal(a.Payload)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		p = append(p, sqlc.CreateEventsParams{
+			Type:        a.Type,
+			LinkID:      a.LinkID,
+			Payload:     payload,
+			WorkspaceID: a.WorkspaceID,
+		})
+	}
+	return execBatch(r.q.CreateEvents(ctx, p).Exec)
+}
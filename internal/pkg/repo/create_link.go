@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
@@ -9,22 +10,46 @@ import (
 )
 
 func (r *Repo) CreateLink(ctx context.Context, args usecase.CreateLinkArgs) (entity.Link, error) {
-	p := sqlc.CreateLinkParams{
-		ShortID: args.ShortID,
-		Href:    args.Href,
+	rules, err := fromEntityRules(args.Rules)
+	if err != nil {
+		return entity.Link{}, err
 	}
-	l, err := r.q.CreateLink(ctx, p)
+	variants, err := fromEntityVariants(args.Variants)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	queryOptions, err := fromEntityQueryOptions(args.QueryOptions)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	metadata, err := fromEntityMetadata(args.Metadata)
 	if err != nil {
 		return entity.Link{}, err
 	}
+	tags := args.Tags
+	if tags == nil {
+		tags = []string{}
+	}
 
-	e := entity.Link{
-		ID:         l.ID,
-		ShortID:    l.ShortID,
-		Href:       l.Href,
-		CreatedAt:  l.CreatedAt,
-		UsageCount: l.UsageCount,
-		UsageAt:    l.UsageAt,
+	p := sqlc.CreateLinkParams{
+		WorkspaceID:    args.WorkspaceID,
+		ShortID:        args.ShortID,
+		Href:           args.Href,
+		PasswordHash:   sql.NullString{String: args.PasswordHash, Valid: args.PasswordHash != ""},
+		Reusable:       args.Reusable,
+		MaxClicks:      sql.NullInt64{Int64: args.MaxClicks, Valid: args.MaxClicks > 0},
+		Rules:          rules,
+		Variants:       variants,
+		StickyVariants: args.StickyVariants,
+		QueryOptions:   queryOptions,
+		Title:          args.Title,
+		Description:    args.Description,
+		Tags:           tags,
+		Metadata:       metadata,
+	}
+	l, err := r.q.CreateLink(ctx, p)
+	if err != nil {
+		return entity.Link{}, err
 	}
-	return e, nil
+	return toEntityLink(l)
 }
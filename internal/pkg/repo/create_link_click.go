@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateLinkClick(ctx context.Context, args usecase.CreateLinkClickArgs) error {
+	p := sqlc.CreateLinkClickParams{
+		LinkID:      args.LinkID,
+		Variant:     sql.NullString{String: args.Variant, Valid: args.Variant != ""},
+		WorkspaceID: args.WorkspaceID,
+	}
+	return r.q.CreateLinkClick(ctx, p)
+}
@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) CreateWebhook(ctx context.Context, args usecase.CreateWebhookArgs) (entity.Webhook, error) {
+	p := sqlc.CreateWebhookParams{
+		Url:         args.URL,
+		Secret:      args.Secret,
+		EventTypes:  args.EventTypes,
+		WorkspaceID: args.WorkspaceID,
+	}
+	w, err := r.q.CreateWebhook(ctx, p)
+	if err != nil {
+		return entity.Webhook{}, err
+	}
+	return toEntityWebhook(w), nil
+}
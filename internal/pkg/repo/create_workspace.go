@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	workspaces_usecase "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+// CreateWorkspace returns usecase.ErrNoResult when the slug is taken.
+func (r *Repo) CreateWorkspace(ctx context.Context, args workspaces_usecase.CreateWorkspaceArgs) (entity.Workspace, error) {
+	w, err := r.q.CreateWorkspace(ctx, sqlc.CreateWorkspaceParams{
+		Slug:     args.Slug,
+		Name:     args.Name,
+		MaxLinks: sql.NullInt64{Int64: args.MaxLinks, Valid: args.MaxLinks > 0},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Workspace{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.Workspace{}, err
+	}
+	return toEntityWorkspace(w), nil
+}
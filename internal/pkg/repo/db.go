@@ -0,0 +1,54 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var isolationLevels = map[string]pgx.TxIsoLevel{
+	"":                "",
+	"read_committed":  pgx.ReadCommitted,
+	"repeatable_read": pgx.RepeatableRead,
+	"serializable":    pgx.Serializable,
+}
+
+func ParseIsolationLevel(s string) (pgx.TxIsoLevel, error) {
+	level, ok := isolationLevels[s]
+	if !ok {
+		return "", fmt.Errorf("unknown isolation level %q", s)
+	}
+	return level, nil
+}
+
+type ConnectPolicy struct {
+	Attempts   int
+	Backoff    time.Duration
+	BackoffMax time.Duration
+}
+
+// WaitForDB calls ping until it succeeds, doubling the delay between attempts
+// up to BackoffMax, so the service can start before the database is up.
+func WaitForDB(ctx context.Context, ping func(context.Context) error, policy ConnectPolicy) error {
+	delay := policy.Backoff
+	for attempt := 1; ; attempt++ {
+		err := ping(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.Attempts {
+			return fmt.Errorf("database is unavailable after %d attempts: %w", attempt, err)
+		}
+		log.Printf("database is unavailable (attempt %d/%d), retrying in %s: %v\n", attempt, policy.Attempts, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, policy.BackoffMax)
+	}
+}
@@ -0,0 +1,45 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForDB(t *testing.T) {
+	r := require.New(t)
+	policy := ConnectPolicy{Attempts: 3, Backoff: time.Millisecond, BackoffMax: 2 * time.Millisecond}
+
+	calls := 0
+	err := WaitForDB(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}, policy)
+	r.NoError(err)
+	r.Equal(3, calls)
+
+	calls = 0
+	err = WaitForDB(context.Background(), func(context.Context) error {
+		calls++
+		return errors.New("connection refused")
+	}, policy)
+	r.ErrorContains(err, "after 3 attempts")
+	r.Equal(3, calls)
+}
+
+func TestIsRetryable(t *testing.T) {
+	r := require.New(t)
+
+	r.True(IsRetryable(fmt.Errorf("repo.UpdateLink: %w", &pgconn.PgError{Code: "40001"})))
+	r.True(IsRetryable(&pgconn.PgError{Code: "40P01"}))
+	r.False(IsRetryable(&pgconn.PgError{Code: "23505"}))
+	r.False(IsRetryable(errors.New("40001")))
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	webhooks_usecase "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) DeleteWebhook(ctx context.Context, args webhooks_usecase.WorkspaceWebhookID) error {
+	n, err := r.q.DeleteWebhook(ctx, sqlc.DeleteWebhookParams(args))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return usecase.ErrNoResult
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	workspaces_usecase "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) DeleteWorkspaceMember(ctx context.Context, args workspaces_usecase.WorkspaceMemberID) error {
+	n, err := r.q.DeleteWorkspaceMember(ctx, sqlc.DeleteWorkspaceMemberParams(args))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return usecase.ErrNoResult
+	}
+	return nil
+}
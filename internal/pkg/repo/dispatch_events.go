@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) DispatchEvents(ctx context.Context, events []entity.Event) error {
+	deliveries := make([]sqlc.CreateWebhookDeliveriesParams, 0, len(events))
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		deliveries = append(deliveries, sqlc.CreateWebhookDeliveriesParams{
+			EventID:     event.ID,
+			WorkspaceID: event.WorkspaceID,
+			EventType:   event.Type,
+		})
+		ids = append(ids, event.ID)
+	}
+
+	if err := execBatch(r.q.CreateWebhookDeliveries(ctx, deliveries).Exec); err != nil {
+		return err
+	}
+	return execBatch(r.q.MarkEventDispatched(ctx, ids).Exec)
+}
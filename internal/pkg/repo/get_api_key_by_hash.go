@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) GetAPIKeyByHash(ctx context.Context, keyHash string) (entity.APIKey, error) {
+	row, err := r.q.GetAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.APIKey{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.APIKey{}, err
+	}
+	return toEntityAPIKey(row), nil
+}
@@ -2,8 +2,8 @@ package repo
 
 import (
 	"context"
-	"database/sql"
 	"errors"
+	"github.com/jackc/pgx/v5"
 
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
 	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
@@ -12,17 +12,10 @@ import (
 func (r *Repo) GetLinkByShortID(ctx context.Context, shortID string) (entity.Link, error) {
 	l, err := r.q.GetLinkByShortID(ctx, shortID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return entity.Link{}, errors.Join(usecase.ErrNoResult, err)
 		}
 		return entity.Link{}, err
 	}
-	return entity.Link{
-		ID:         l.ID,
-		ShortID:    l.ShortID,
-		Href:       l.Href,
-		CreatedAt:  l.CreatedAt,
-		UsageCount: l.UsageCount,
-		UsageAt:    l.UsageAt,
-	}, err
+	return toEntityLink(l)
 }
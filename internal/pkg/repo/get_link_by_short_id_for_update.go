@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	links_usecase "github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) GetLinkByShortIDForUpdate(ctx context.Context, args links_usecase.WorkspaceShortID) (entity.Link, error) {
+	l, err := r.q.GetLinkByShortIDForUpdate(ctx, sqlc.GetLinkByShortIDForUpdateParams(args))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Link{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.Link{}, err
+	}
+	return toEntityLink(l)
+}
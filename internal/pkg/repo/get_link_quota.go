@@ -0,0 +1,30 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+// GetLinkQuota locks the workspace when it has a limit so that concurrent
+// creations are counted one after another.
+func (r *Repo) GetLinkQuota(ctx context.Context, workspaceID int64) (entity.LinkQuota, error) {
+	w, err := r.q.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		return entity.LinkQuota{}, fmt.Errorf("q.GetWorkspace: %w", err)
+	}
+	if !w.MaxLinks.Valid {
+		return entity.LinkQuota{}, nil
+	}
+
+	w, err = r.q.GetWorkspaceForUpdate(ctx, workspaceID)
+	if err != nil {
+		return entity.LinkQuota{}, fmt.Errorf("q.GetWorkspaceForUpdate: %w", err)
+	}
+	links, err := r.q.CountWorkspaceLinks(ctx, workspaceID)
+	if err != nil {
+		return entity.LinkQuota{}, fmt.Errorf("q.CountWorkspaceLinks: %w", err)
+	}
+	return entity.LinkQuota{MaxLinks: w.MaxLinks.Int64, Links: links}, nil
+}
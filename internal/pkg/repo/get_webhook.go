@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	webhooks_usecase "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) GetWebhook(ctx context.Context, args webhooks_usecase.WorkspaceWebhookID) (entity.Webhook, error) {
+	w, err := r.q.GetWebhook(ctx, sqlc.GetWebhookParams(args))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Webhook{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.Webhook{}, err
+	}
+	return toEntityWebhook(w), nil
+}
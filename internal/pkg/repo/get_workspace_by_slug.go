@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) GetWorkspaceBySlug(ctx context.Context, slug string) (entity.Workspace, error) {
+	row, err := r.q.GetWorkspaceBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Workspace{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.Workspace{}, err
+	}
+	return toEntityWorkspace(row), nil
+}
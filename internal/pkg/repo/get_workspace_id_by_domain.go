@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) GetWorkspaceIDByDomain(ctx context.Context, domain string) (int64, error) {
+	d, err := r.q.GetWorkspaceDomain(ctx, domain)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.Join(usecase.ErrNoResult, err)
+		}
+		return 0, err
+	}
+	return d.WorkspaceID, nil
+}
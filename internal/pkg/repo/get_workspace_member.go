@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	workspaces_usecase "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+func (r *Repo) GetWorkspaceMember(ctx context.Context, args workspaces_usecase.WorkspaceMemberID) (entity.Member, error) {
+	row, err := r.q.GetWorkspaceMember(ctx, sqlc.GetWorkspaceMemberParams(args))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Member{}, errors.Join(usecase.ErrNoResult, err)
+		}
+		return entity.Member{}, err
+	}
+	return toEntityMember(row), nil
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) ImportLinks(ctx context.Context, args []usecase.ImportLinkArgs) (int64, error) {
+	p := make([]sqlc.ImportLinksParams, 0, len(args))
+	for _, a := range args {
+		p = append(p, sqlc.ImportLinksParams{
+			WorkspaceID: a.WorkspaceID,
+			ShortID:     a.ShortID,
+			Href:        a.Href,
+		})
+	}
+	return r.q.ImportLinks(ctx, p)
+}
@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) IsShortIDInWorkspace(ctx context.Context, args usecase.WorkspaceShortID) (bool, error) {
+	found, err := r.q.IsShortIDInWorkspace(ctx, sqlc.IsShortIDInWorkspaceParams(args))
+	if err != nil {
+		return false, err
+	}
+	return found, nil
+}
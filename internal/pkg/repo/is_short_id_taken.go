@@ -0,0 +1,11 @@
+package repo
+
+import "context"
+
+func (r *Repo) IsShortIDTaken(ctx context.Context, shortID string) (bool, error) {
+	taken, err := r.q.IsShortIDTaken(ctx, shortID)
+	if err != nil {
+		return false, err
+	}
+	return taken, nil
+}
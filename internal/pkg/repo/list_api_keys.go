@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+)
+
+func (r *Repo) ListAPIKeys(ctx context.Context, workspaceID int64) ([]entity.APIKey, error) {
+	rows, err := r.q.ListAPIKeys(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]entity.APIKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, toEntityAPIKey(row))
+	}
+	return keys, nil
+}
@@ -0,0 +1,44 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) ListAuditEntries(ctx context.Context, args usecase.ListAuditEntriesArgs) ([]entity.AuditEntry, error) {
+	p := sqlc.ListAuditEntriesParams{
+		WorkspaceID: args.WorkspaceID,
+		ShortID:     sql.NullString{String: args.ShortID, Valid: args.ShortID != ""},
+		Action:      sql.NullString{String: args.Action, Valid: args.Action != ""},
+		ActorType:   sql.NullString{String: args.ActorType, Valid: args.ActorType != ""},
+		ActorID:     sql.NullString{String: args.ActorID, Valid: args.ActorID != ""},
+		Since:       sql.NullTime{Time: args.Since, Valid: !args.Since.IsZero()},
+		Until:       sql.NullTime{Time: args.Until, Valid: !args.Until.IsZero()},
+		BeforeID:    sql.NullInt64{Int64: args.BeforeID, Valid: args.BeforeID != 0},
+		MaxResults:  args.Limit,
+	}
+	rows, err := r.q.ListAuditEntries(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]entity.AuditEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, entity.AuditEntry{
+			ID:        row.ID,
+			LinkID:    row.LinkID,
+			ShortID:   row.ShortID,
+			Action:    row.Action,
+			ActorType: row.ActorType,
+			ActorID:   row.ActorID,
+			OldValue:  row.OldValue,
+			NewValue:  row.NewValue,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,41 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) ListLinks(ctx context.Context, args usecase.ListLinksArgs) ([]entity.Link, error) {
+	metadata, err := fromEntityMetadata(args.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	tags := args.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	rows, err := r.q.ListLinks(ctx, sqlc.ListLinksParams{
+		WorkspaceID: args.WorkspaceID,
+		Tags:        tags,
+		Metadata:    metadata,
+		BeforeID:    sql.NullInt64{Int64: args.BeforeID, Valid: args.BeforeID != 0},
+		MaxResults:  args.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	links := make([]entity.Link, 0, len(rows))
+	for _, row := range rows {
+		link, err := toEntityLink(row)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
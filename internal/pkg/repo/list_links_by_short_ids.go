@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+func (r *Repo) ListLinksByShortIDs(ctx context.Context, shortIDs []string) ([]entity.Link, error) {
+	rows, err := r.q.ListLinksByShortIDs(ctx, shortIDs)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]entity.Link, 0, len(rows))
+	for _, row := range rows {
+		link, err := toEntityLink(row)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
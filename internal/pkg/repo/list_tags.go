@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+func (r *Repo) ListTags(ctx context.Context, workspaceID int64) ([]entity.TagCount, error) {
+	rows, err := r.q.ListTags(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	tags := make([]entity.TagCount, 0, len(rows))
+	for _, row := range rows {
+		tags = append(tags, entity.TagCount(row))
+	}
+	return tags, nil
+}
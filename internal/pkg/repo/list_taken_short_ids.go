@@ -0,0 +1,7 @@
+package repo
+
+import "context"
+
+func (r *Repo) ListTakenShortIDs(ctx context.Context, shortIDs []string) ([]string, error) {
+	return r.q.ListTakenShortIDs(ctx, shortIDs)
+}
@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+)
+
+func (r *Repo) ListUndispatchedEvents(ctx context.Context, limit int32) ([]entity.Event, error) {
+	rows, err := r.q.ListUndispatchedEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]entity.Event, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, entity.Event{
+			ID:          row.ID,
+			WorkspaceID: row.WorkspaceID,
+			Type:        row.Type,
+			LinkID:      row.LinkID,
+			Payload:     row.Payload,
+			CreatedAt:   row.CreatedAt,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/kirillismad/go-url-shortener/internal/pkg/events"
+)
+
+func (r *Repo) ListUnpublishedEvents(ctx context.Context, limit int32) ([]events.Message, error) {
+	rows, err := r.q.ListUnpublishedEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]events.Message, 0, len(rows))
+	for _, row := range rows {
+		messages = append(messages, events.Message{
+			ID:        row.ID,
+			Type:      row.Type,
+			Key:       strconv.FormatInt(row.LinkID, 10),
+			Payload:   row.Payload,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return messages, nil
+}
@@ -0,0 +1,28 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) ListWebhookDeliveries(ctx context.Context, args usecase.ListWebhookDeliveriesArgs) ([]entity.Delivery, error) {
+	p := sqlc.ListWebhookDeliveriesParams{
+		WebhookID:  args.WebhookID,
+		Status:     sql.NullString{String: args.Status, Valid: args.Status != ""},
+		MaxResults: args.Limit,
+	}
+	rows, err := r.q.ListWebhookDeliveries(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]entity.Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toEntityDelivery(row))
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+)
+
+func (r *Repo) ListWebhooks(ctx context.Context, workspaceID int64) ([]entity.Webhook, error) {
+	rows, err := r.q.ListWebhooks(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]entity.Webhook, 0, len(rows))
+	for _, row := range rows {
+		webhooks = append(webhooks, toEntityWebhook(row))
+	}
+	return webhooks, nil
+}
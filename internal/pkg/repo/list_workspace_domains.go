@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+)
+
+func (r *Repo) ListWorkspaceDomains(ctx context.Context, workspaceID int64) ([]entity.Domain, error) {
+	rows, err := r.q.ListWorkspaceDomains(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]entity.Domain, 0, len(rows))
+	for _, row := range rows {
+		domains = append(domains, toEntityDomain(row))
+	}
+	return domains, nil
+}
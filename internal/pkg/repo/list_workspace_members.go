@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+)
+
+func (r *Repo) ListWorkspaceMembers(ctx context.Context, workspaceID int64) ([]entity.Member, error) {
+	rows, err := r.q.ListWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]entity.Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, toEntityMember(row))
+	}
+	return members, nil
+}
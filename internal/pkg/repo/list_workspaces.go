@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+)
+
+func (r *Repo) ListWorkspaces(ctx context.Context) ([]entity.Workspace, error) {
+	rows, err := r.q.ListWorkspaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]entity.Workspace, 0, len(rows))
+	for _, row := range rows {
+		workspaces = append(workspaces, toEntityWorkspace(row))
+	}
+	return workspaces, nil
+}
@@ -0,0 +1,9 @@
+package repo
+
+import "context"
+
+const outboxLockKey = 7_000_001
+
+func (r *Repo) LockOutbox(ctx context.Context) (bool, error) {
+	return r.q.LockOutbox(ctx, outboxLockKey)
+}
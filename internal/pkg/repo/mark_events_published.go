@@ -0,0 +1,7 @@
+package repo
+
+import "context"
+
+func (r *Repo) MarkEventsPublished(ctx context.Context, ids []int64) error {
+	return r.q.MarkEventsPublished(ctx, ids)
+}
@@ -0,0 +1,7 @@
+package repo
+
+import "context"
+
+func (r *Repo) MarkWebhookDeliveryDelivered(ctx context.Context, id int64) error {
+	return r.q.MarkWebhookDeliveryDelivered(ctx, id)
+}
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) MarkWebhookDeliveryFailed(ctx context.Context, args usecase.MarkWebhookDeliveryFailedArgs) error {
+	p := sqlc.MarkWebhookDeliveryFailedParams{
+		ID:            args.ID,
+		Status:        args.Status,
+		NextAttemptAt: args.NextAttemptAt,
+		LastError:     sql.NullString{String: args.LastError, Valid: args.LastError != ""},
+	}
+	return r.q.MarkWebhookDeliveryFailed(ctx, p)
+}
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) PurgeDeletedLinks(ctx context.Context, args usecase.PurgeDeletedLinksArgs) (int64, error) {
+	return r.q.PurgeDeletedLinks(ctx, sqlc.PurgeDeletedLinksParams{
+		Before:    args.Before,
+		BatchSize: int32(args.Limit),
+		Action:    entity.AuditActionPurge,
+		ActorType: args.Actor.Type,
+		ActorID:   args.Actor.ID,
+	})
+}
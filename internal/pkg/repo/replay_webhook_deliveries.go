@@ -0,0 +1,7 @@
+package repo
+
+import "context"
+
+func (r *Repo) ReplayWebhookDeliveries(ctx context.Context, webhookID int64) (int64, error) {
+	return r.q.ReplayWebhookDeliveries(ctx, webhookID)
+}
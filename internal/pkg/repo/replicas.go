@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Replicas balances reads over read-only pools round robin, skipping the
+// ones that failed their last health check.
+type Replicas struct {
+	pools   []*pgxpool.Pool
+	healthy []atomic.Bool
+	next    atomic.Uint64
+}
+
+func NewReplicas(pools []*pgxpool.Pool) *Replicas {
+	r := &Replicas{
+		pools:   pools,
+		healthy: make([]atomic.Bool, len(pools)),
+	}
+	for i := range r.healthy {
+		r.healthy[i].Store(true)
+	}
+	return r
+}
+
+// Pick returns a healthy replica, or nil when there is none.
+func (r *Replicas) Pick() *pgxpool.Pool {
+	if r == nil {
+		return nil
+	}
+	start := r.next.Add(1)
+	for i := range r.pools {
+		idx := (start + uint64(i)) % uint64(len(r.pools))
+		if r.healthy[idx].Load() {
+			return r.pools[idx]
+		}
+	}
+	return nil
+}
+
+// Check pings every replica and updates its health.
+func (r *Replicas) Check(ctx context.Context) error {
+	var errs []error
+	for i, pool := range r.pools {
+		err := pool.Ping(ctx)
+		r.healthy[i].Store(err == nil)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("replica %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Replicas) Close() {
+	for _, pool := range r.pools {
+		pool.Close()
+	}
+}
@@ -1,7 +1,16 @@
 package repo
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
 	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	webhooks_entity "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/entity"
+	webhooks_usecase "github.com/kirillismad/go-url-shortener/internal/apps/webhooks/usecase"
+	workspaces_entity "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	workspaces_usecase "github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/events"
 	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
 )
 
@@ -16,3 +25,189 @@ func newRepo(q *sqlc.Queries) *Repo {
 func NewLinkRepo(q *sqlc.Queries) usecase.LinkRepo {
 	return newRepo(q)
 }
+
+func NewWebhookRepo(q *sqlc.Queries) webhooks_usecase.WebhookRepo {
+	return newRepo(q)
+}
+
+func NewWorkspaceRepo(q *sqlc.Queries) workspaces_usecase.WorkspaceRepo {
+	return newRepo(q)
+}
+
+func NewOutboxRepo(q *sqlc.Queries) events.Outbox {
+	return newRepo(q)
+}
+
+type redirectRuleModel struct {
+	Languages []string `json:"languages,omitempty"`
+	Devices   []string `json:"devices,omitempty"`
+	Countries []string `json:"countries,omitempty"`
+	Href      string   `json:"href"`
+}
+
+type variantModel struct {
+	Name   string `json:"name"`
+	Href   string `json:"href"`
+	Weight int    `json:"weight"`
+}
+
+type queryOptionsModel struct {
+	Passthrough *bool    `json:"passthrough,omitempty"`
+	Conflict    string   `json:"conflict,omitempty"`
+	Allowlist   []string `json:"allowlist"`
+}
+
+// execBatch runs a queued batch and returns its first error; later queries
+// of a failed batch only report the aborted transaction.
+func execBatch(exec func(func(int, error))) error {
+	var first error
+	exec(func(_ int, err error) {
+		if first == nil {
+			first = err
+		}
+	})
+	return first
+}
+
+func toEntityLink(l sqlc.Link) (entity.Link, error) {
+	var rules []redirectRuleModel
+	if err := json.Unmarshal(l.Rules, &rules); err != nil {
+		return entity.Link{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	var variants []variantModel
+	if err := json.Unmarshal(l.Variants, &variants); err != nil {
+		return entity.Link{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	var queryOptions queryOptionsModel
+	if err := json.Unmarshal(l.QueryOptions, &queryOptions); err != nil {
+		return entity.Link{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	var metadata map[string]string
+	if err := json.Unmarshal(l.Metadata, &metadata); err != nil {
+		return entity.Link{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	e := entity.Link{
+		ID:             l.ID,
+		ShortID:        l.ShortID,
+		Href:           l.Href,
+		CreatedAt:      l.CreatedAt,
+		UsageCount:     l.UsageCount,
+		UsageAt:        l.UsageAt,
+		PasswordHash:   l.PasswordHash.String,
+		MaxClicks:      l.MaxClicks.Int64,
+		StickyVariants: l.StickyVariants,
+		QueryOptions:   entity.QueryOptions(queryOptions),
+		DeletedAt:      l.DeletedAt.Time,
+		Title:          l.Title,
+		Description:    l.Description,
+		Tags:           l.Tags,
+		Metadata:       metadata,
+		WorkspaceID:    l.WorkspaceID,
+	}
+	for _, r := range rules {
+		e.Rules = append(e.Rules, entity.RedirectRule(r))
+	}
+	for _, v := range variants {
+		e.Variants = append(e.Variants, entity.Variant(v))
+	}
+	return e, nil
+}
+
+func fromEntityRules(rules []entity.RedirectRule) (json.RawMessage, error) {
+	models := make([]redirectRuleModel, 0, len(rules))
+	for _, r := range rules {
+		models = append(models, redirectRuleModel(r))
+	}
+	b, err := json.Marshal(models)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return b, nil
+}
+
+func fromEntityVariants(variants []entity.Variant) (json.RawMessage, error) {
+	models := make([]variantModel, 0, len(variants))
+	for _, v := range variants {
+		models = append(models, variantModel(v))
+	}
+	b, err := json.Marshal(models)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return b, nil
+}
+
+func fromEntityQueryOptions(options entity.QueryOptions) (json.RawMessage, error) {
+	b, err := json.Marshal(queryOptionsModel(options))
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return b, nil
+}
+
+// fromEntityMetadata stores nil metadata as an empty object, which also
+// makes it match any metadata filter.
+func fromEntityMetadata(metadata map[string]string) (json.RawMessage, error) {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return b, nil
+}
+
+func toEntityWebhook(w sqlc.Webhook) webhooks_entity.Webhook {
+	return webhooks_entity.Webhook{
+		ID:         w.ID,
+		URL:        w.Url,
+		Secret:     w.Secret,
+		EventTypes: w.EventTypes,
+		CreatedAt:  w.CreatedAt,
+	}
+}
+
+func toEntityDelivery(d sqlc.WebhookDelivery) webhooks_entity.Delivery {
+	return webhooks_entity.Delivery{
+		ID:            d.ID,
+		WebhookID:     d.WebhookID,
+		EventID:       d.EventID,
+		Status:        d.Status,
+		Attempts:      int(d.Attempts),
+		NextAttemptAt: d.NextAttemptAt,
+		LastError:     d.LastError.String,
+		DeliveredAt:   d.DeliveredAt.Time,
+		CreatedAt:     d.CreatedAt,
+	}
+}
+
+func toEntityWorkspace(w sqlc.Workspace) workspaces_entity.Workspace {
+	return workspaces_entity.Workspace{
+		ID:        w.ID,
+		Slug:      w.Slug,
+		Name:      w.Name,
+		MaxLinks:  w.MaxLinks.Int64,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func toEntityMember(m sqlc.WorkspaceMember) workspaces_entity.Member {
+	return workspaces_entity.Member(m)
+}
+
+func toEntityAPIKey(k sqlc.ApiKey) workspaces_entity.APIKey {
+	return workspaces_entity.APIKey{
+		ID:          k.ID,
+		WorkspaceID: k.WorkspaceID,
+		Name:        k.Name,
+		Role:        k.Role,
+		Fingerprint: k.KeyHash[:16],
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+func toEntityDomain(d sqlc.WorkspaceDomain) workspaces_entity.Domain {
+	return workspaces_entity.Domain(d)
+}
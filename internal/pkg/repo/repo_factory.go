@@ -2,42 +2,104 @@ package repo
 
 import (
 	"context"
-	"database/sql"
+	"errors"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
 )
 
 type NewRepoFn[R any] func(q *sqlc.Queries) R
 
+// TxPolicy controls how InTransaction begins transactions and how many times
+// it reruns one that failed with a serialization failure or deadlock.
+type TxPolicy struct {
+	Isolation    pgx.TxIsoLevel
+	MaxRetries   int
+	RetryBackoff time.Duration
+}
+
 type RepoFactory[R any] struct {
-	db        *sql.DB
+	pool      *pgxpool.Pool
+	replicas  *Replicas
 	newRepoFn NewRepoFn[R]
+	txPolicy  TxPolicy
 }
 
-func NewRepoFactory[R any](db *sql.DB, newRepoFn NewRepoFn[R]) *RepoFactory[R] {
+func NewRepoFactory[R any](pool *pgxpool.Pool, newRepoFn NewRepoFn[R]) *RepoFactory[R] {
 	return &RepoFactory[R]{
-		db:        db,
+		pool:      pool,
 		newRepoFn: newRepoFn,
 	}
 }
 
+func (r *RepoFactory[R]) WithTxPolicy(policy TxPolicy) *RepoFactory[R] {
+	r.txPolicy = policy
+	return r
+}
+
+func (r *RepoFactory[R]) WithReplicas(replicas *Replicas) *RepoFactory[R] {
+	r.replicas = replicas
+	return r
+}
+
 func (r *RepoFactory[R]) GetRepo() R {
-	return r.newRepoFn(sqlc.New(r.db))
+	return r.newRepoFn(sqlc.New(r.pool))
+}
+
+// GetReadRepo reads from a healthy replica unless ctx asks for primary reads
+// or no replica is available.
+func (r *RepoFactory[R]) GetReadRepo(ctx context.Context) R {
+	if usecase.PrimaryReads(ctx) {
+		return r.GetRepo()
+	}
+	if replica := r.replicas.Pick(); replica != nil {
+		return r.newRepoFn(sqlc.New(replica))
+	}
+	return r.GetRepo()
 }
 
 func (r *RepoFactory[R]) InTransaction(ctx context.Context, txFn func(R) error) error {
-	tx, err := r.db.BeginTx(ctx, nil)
+	for attempt := 0; ; attempt++ {
+		err := r.inTransaction(ctx, txFn)
+		if err == nil || attempt >= r.txPolicy.MaxRetries || !IsRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		case <-time.After(r.txPolicy.RetryBackoff << attempt):
+		}
+	}
+}
+
+func (r *RepoFactory[R]) inTransaction(ctx context.Context, txFn func(R) error) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: r.txPolicy.Isolation})
 	if err != nil {
 		return err
 	}
-	defer tx.Rollback()
+	defer tx.Rollback(ctx)
 
 	if err := txFn(r.newRepoFn(sqlc.New(tx))); err != nil {
 		return err
 	}
 
-	if err := tx.Commit(); err != nil {
+	if err := tx.Commit(ctx); err != nil {
 		return err
 	}
 	return nil
 }
+
+// IsRetryable reports whether err is a serialization failure or a deadlock,
+// after which the whole transaction can be rerun.
+func IsRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == "40001" || pgErr.Code == "40P01"
+}
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+)
+
+func (r *Repo) RestoreLink(ctx context.Context, id int64) (entity.Link, error) {
+	l, err := r.q.RestoreLink(ctx, id)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	return toEntityLink(l)
+}
@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) SetWorkspaceMember(ctx context.Context, args usecase.SetWorkspaceMemberArgs) (entity.Member, error) {
+	m, err := r.q.UpsertWorkspaceMember(ctx, sqlc.UpsertWorkspaceMemberParams(args))
+	if err != nil {
+		return entity.Member{}, err
+	}
+	return toEntityMember(m), nil
+}
@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) UpdateLink(ctx context.Context, args usecase.UpdateLinkArgs) (entity.Link, error) {
+	rules, err := fromEntityRules(args.Rules)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	variants, err := fromEntityVariants(args.Variants)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	queryOptions, err := fromEntityQueryOptions(args.QueryOptions)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	metadata, err := fromEntityMetadata(args.Metadata)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	tags := args.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	p := sqlc.UpdateLinkParams{
+		ID:             args.ID,
+		Href:           args.Href,
+		PasswordHash:   sql.NullString{String: args.PasswordHash, Valid: args.PasswordHash != ""},
+		MaxClicks:      sql.NullInt64{Int64: args.MaxClicks, Valid: args.MaxClicks > 0},
+		Rules:          rules,
+		Variants:       variants,
+		StickyVariants: args.StickyVariants,
+		QueryOptions:   queryOptions,
+		Title:          args.Title,
+		Description:    args.Description,
+		Tags:           tags,
+		Metadata:       metadata,
+	}
+	l, err := r.q.UpdateLink(ctx, p)
+	if err != nil {
+		return entity.Link{}, err
+	}
+	return toEntityLink(l)
+}
@@ -1,11 +1,23 @@
 package repo
 
-import "context"
+import (
+	"context"
+	"errors"
 
-func (r *Repo) UpdateLinkUsageInfo(ctx context.Context, id int64) error {
-	err := r.q.UpdateLinkUsageInfo(ctx, id)
+	"github.com/jackc/pgx/v5"
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+)
+
+func (r *Repo) UpdateLinkUsageInfo(ctx context.Context, id int64) (int64, error) {
+	row, err := r.q.UpdateLinkUsageInfo(ctx, id)
 	if err != nil {
-		return err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, errors.Join(usecase.ErrLinkExhausted, err)
+		}
+		return 0, err
+	}
+	if row.Deleted {
+		return 0, usecase.ErrLinkDeleted
 	}
-	return nil
+	return row.UsageCount, nil
 }
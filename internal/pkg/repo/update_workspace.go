@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/entity"
+	"github.com/kirillismad/go-url-shortener/internal/apps/workspaces/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/sqlc"
+)
+
+func (r *Repo) UpdateWorkspace(ctx context.Context, args usecase.UpdateWorkspaceArgs) (entity.Workspace, error) {
+	w, err := r.q.UpdateWorkspace(ctx, sqlc.UpdateWorkspaceParams{
+		ID:       args.ID,
+		Name:     args.Name,
+		MaxLinks: sql.NullInt64{Int64: args.MaxLinks, Valid: args.MaxLinks > 0},
+	})
+	if err != nil {
+		return entity.Workspace{}, err
+	}
+	return toEntityWorkspace(w), nil
+}
@@ -0,0 +1,101 @@
+package shortid
+
+import (
+	"log"
+	"math"
+)
+
+// collisionWindow is the number of uniqueness checks the collision rate is
+// measured over.
+const collisionWindow = 100
+
+type Stats struct {
+	Len           int
+	MinLen        int
+	MaxLen        int
+	AlphabetSize  int
+	Capacity      float64
+	Links         int64
+	Utilization   float64
+	CollisionRate float64
+}
+
+// Len returns the length of the IDs generated now.
+func (p *Policy) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.len
+}
+
+// Observe records whether a generated ID was already taken. Once the share of
+// taken IDs over the last window exceeds GrowAt, the length grows.
+func (p *Policy) Observe(taken bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.samples++
+	if taken {
+		p.collisions++
+	}
+	if p.samples < collisionWindow {
+		return
+	}
+	p.rate = float64(p.collisions) / float64(p.samples)
+	p.samples, p.collisions = 0, 0
+	if p.growAt > 0 && p.rate > p.growAt {
+		p.grow("collision rate", p.rate)
+	}
+}
+
+// SetLinkCount records the number of existing IDs of the given length and
+// grows the length if they fill more than GrowAt of its keyspace. It reports
+// whether the length grew.
+func (p *Policy) SetLinkCount(length int, count int64) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if length != p.len {
+		return false
+	}
+	p.links = count
+	utilization := float64(count) / p.capacity()
+	if p.growAt > 0 && utilization > p.growAt {
+		return p.grow("keyspace utilization", utilization)
+	}
+	return false
+}
+
+func (p *Policy) Stats() Stats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := Stats{
+		Len:           p.len,
+		MinLen:        p.minLen,
+		MaxLen:        p.maxLen,
+		AlphabetSize:  len(p.alphabet),
+		Capacity:      p.capacity(),
+		Links:         p.links,
+		CollisionRate: p.rate,
+	}
+	if p.links >= 0 {
+		stats.Utilization = float64(p.links) / stats.Capacity
+	}
+	return stats
+}
+
+func (p *Policy) capacity() float64 {
+	return math.Pow(float64(len(p.alphabet)), float64(p.len))
+}
+
+func (p *Policy) grow(reason string, value float64) bool {
+	if p.len >= p.maxLen {
+		log.Printf("short IDs: %s %.3f above %.3f, but length %d is already the maximum", reason, value, p.growAt, p.len)
+		return false
+	}
+	p.len++
+	p.samples, p.collisions, p.rate, p.links = 0, 0, 0, -1
+	log.Printf("short IDs: %s %.3f above %.3f, length grown to %d", reason, value, p.growAt, p.len)
+	return true
+}
@@ -0,0 +1,54 @@
+package shortid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyGrowsOnCollisions(t *testing.T) {
+	r := require.New(t)
+	p, err := NewPolicy(PolicyParams{Alphabet: "ab", Len: 4, MaxLen: 5, GrowAt: 0.5})
+	r.NoError(err)
+
+	for i := 0; i < collisionWindow; i++ {
+		p.Observe(i%2 == 0)
+	}
+	r.Equal(4, p.Len(), "a rate equal to the threshold does not grow")
+	r.Equal(0.5, p.Stats().CollisionRate)
+
+	for i := 0; i < collisionWindow; i++ {
+		p.Observe(true)
+	}
+	r.Equal(5, p.Len())
+	id, err := p.Generate()
+	r.NoError(err)
+	r.Len(id, 5)
+
+	for i := 0; i < collisionWindow; i++ {
+		p.Observe(true)
+	}
+	r.Equal(5, p.Len(), "never beyond the maximum")
+}
+
+func TestPolicyGrowsOnUtilization(t *testing.T) {
+	r := require.New(t)
+	p, err := NewPolicy(PolicyParams{Alphabet: "ab", Len: 3, MaxLen: 6, GrowAt: 0.5})
+	r.NoError(err)
+
+	r.False(p.SetLinkCount(3, 4))
+	r.Equal(Stats{Len: 3, MinLen: 3, MaxLen: 6, AlphabetSize: 2, Capacity: 8, Links: 4, Utilization: 0.5}, p.Stats())
+
+	r.False(p.SetLinkCount(4, 100), "counts of another length are ignored")
+	r.True(p.SetLinkCount(3, 5))
+	r.Equal(4, p.Len())
+	r.Equal(int64(-1), p.Stats().Links)
+}
+
+func TestPolicyWithoutGrowth(t *testing.T) {
+	p, err := NewPolicy(PolicyParams{Alphabet: "ab", Len: 3, MaxLen: 6})
+	require.NoError(t, err)
+
+	require.False(t, p.SetLinkCount(3, 8))
+	require.Equal(t, 3, p.Len())
+}
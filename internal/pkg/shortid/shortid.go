@@ -0,0 +1,183 @@
+package shortid
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// Ambiguous are the characters easily confused with one another when an ID
+// is read aloud or typed from print.
+const Ambiguous = "0O1lI"
+
+var (
+	ErrInvalidPolicy = errors.New("invalid short ID policy")
+	ErrBlocked       = errors.New("no short ID outside the blocklist")
+)
+
+// maxGenerateAttempts bounds the draws of Generate, so that a blocklist
+// covering (nearly) every ID fails requests instead of hanging them.
+const maxGenerateAttempts = 1000
+
+// Policy generates and validates short IDs.
+//
+// IDs are generated with Len characters from the alphabet, minus the
+// ambiguous characters when they are excluded. Any ID of MinLen to MaxLen
+// characters from the full alphabet is valid, so existing links keep working
+// after Len is changed or ambiguous characters are excluded.
+//
+// When GrowAt is set, Len grows towards MaxLen as the keyspace fills up, see
+// Observe and SetLinkCount.
+type Policy struct {
+	alphabet  []rune
+	accept    map[rune]struct{}
+	minLen    int
+	maxLen    int
+	growAt    float64
+	blocklist atomic.Pointer[[]string]
+
+	mu         sync.Mutex
+	len        int
+	samples    int
+	collisions int
+	rate       float64
+	links      int64
+}
+
+type PolicyParams struct {
+	Alphabet         string
+	Len              int
+	MinLen           int
+	MaxLen           int
+	ExcludeAmbiguous bool
+	Blocklist        []string
+	// GrowAt is the collision rate or keyspace utilization above which the
+	// generated length grows by one, 0 disables growth.
+	GrowAt float64
+}
+
+func NewPolicy(params PolicyParams) (*Policy, error) {
+	p := &Policy{
+		accept: make(map[rune]struct{}, len(params.Alphabet)),
+		len:    params.Len,
+		minLen: params.MinLen,
+		maxLen: params.MaxLen,
+		growAt: params.GrowAt,
+		links:  -1,
+	}
+	if p.minLen == 0 {
+		p.minLen = p.len
+	}
+	if p.maxLen == 0 {
+		p.maxLen = p.len
+	}
+	if p.len < 1 || p.minLen > p.len || p.len > p.maxLen {
+		return nil, fmt.Errorf("%w: length %d is not within %d..%d", ErrInvalidPolicy, p.len, p.minLen, p.maxLen)
+	}
+
+	for _, r := range params.Alphabet {
+		if !isUnreserved(r) {
+			return nil, fmt.Errorf("%w: alphabet character %q is not allowed in a URL path unescaped", ErrInvalidPolicy, r)
+		}
+		if _, ok := p.accept[r]; ok {
+			return nil, fmt.Errorf("%w: alphabet character %q is repeated", ErrInvalidPolicy, r)
+		}
+		p.accept[r] = struct{}{}
+		if params.ExcludeAmbiguous && strings.ContainsRune(Ambiguous, r) {
+			continue
+		}
+		p.alphabet = append(p.alphabet, r)
+	}
+	if len(p.alphabet) < 2 {
+		return nil, fmt.Errorf("%w: alphabet needs at least 2 usable characters", ErrInvalidPolicy)
+	}
+
+	if err := p.SetBlocklist(params.Blocklist); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// isUnreserved reports whether r is an unreserved URI character (RFC 3986),
+// the only ones that appear in a path as is.
+func isUnreserved(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	default:
+		return r == '-' || r == '.' || r == '_' || r == '~'
+	}
+}
+
+// SetBlocklist replaces the words generated IDs must not contain. Words of
+// a single character once normalized would block a whole alphabet
+// character, or every ID, and are rejected; the blocklist is then left as is.
+func (p *Policy) SetBlocklist(words []string) error {
+	blocklist := make([]string, 0, len(words))
+	for _, word := range words {
+		normalized := normalize(word)
+		switch {
+		case normalized == "":
+			continue
+		case utf8.RuneCountInString(normalized) < 2:
+			return fmt.Errorf("%w: blocklist word %q is a single character once normalized", ErrInvalidPolicy, word)
+		}
+		blocklist = append(blocklist, normalized)
+	}
+	p.blocklist.Store(&blocklist)
+	return nil
+}
+
+func (p *Policy) Valid(id string) bool {
+	if len(id) < p.minLen || len(id) > p.maxLen {
+		return false
+	}
+	for _, r := range id {
+		if _, ok := p.accept[r]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Generate returns a random ID that contains no blocklisted word.
+func (p *Policy) Generate() (string, error) {
+	for i := 0; i < maxGenerateAttempts; i++ {
+		id := p.random()
+		if !p.Blocked(id) {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %d IDs drawn", ErrBlocked, maxGenerateAttempts)
+}
+
+func (p *Policy) random() string {
+	n := p.Len()
+	b := make([]rune, 0, n)
+	for i := 0; i < n; i++ {
+		b = append(b, p.alphabet[rand.Intn(len(p.alphabet))])
+	}
+	return string(b)
+}
+
+// Blocked reports whether id contains a blocklisted word, ignoring case and
+// common digit substitutions.
+func (p *Policy) Blocked(id string) bool {
+	id = normalize(id)
+	for _, word := range *p.blocklist.Load() {
+		if strings.Contains(id, word) {
+			return true
+		}
+	}
+	return false
+}
+
+var substitutions = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "-", "", ".", "", "_", "", "~", "")
+
+func normalize(s string) string {
+	return substitutions.Replace(strings.ToLower(s))
+}
@@ -0,0 +1,88 @@
+package shortid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPolicyRejects(t *testing.T) {
+	tests := []PolicyParams{
+		{Alphabet: "abc]", Len: 4},
+		{Alphabet: "ab^c", Len: 4},
+		{Alphabet: `ab\c`, Len: 4},
+		{Alphabet: "ab/c", Len: 4},
+		{Alphabet: "abca", Len: 4},
+		{Alphabet: "0O1", Len: 4, ExcludeAmbiguous: true},
+		{Alphabet: "abc", Len: 4, MinLen: 5},
+		{Alphabet: "abc", Len: 4, MaxLen: 3},
+	}
+	for _, params := range tests {
+		_, err := NewPolicy(params)
+		require.ErrorIs(t, err, ErrInvalidPolicy, params)
+	}
+}
+
+func TestPolicyValid(t *testing.T) {
+	p, err := NewPolicy(PolicyParams{Alphabet: "abc-01", Len: 4, MinLen: 3, MaxLen: 6})
+	require.NoError(t, err)
+
+	require.True(t, p.Valid("a-c"))
+	require.True(t, p.Valid("abc-01"))
+	require.False(t, p.Valid("ab"))
+	require.False(t, p.Valid("abc-010"))
+	require.False(t, p.Valid("abd"))
+	require.False(t, p.Valid("a]c"))
+}
+
+func TestPolicyGenerate(t *testing.T) {
+	p, err := NewPolicy(PolicyParams{Alphabet: "0O1lIab", Len: 7, MinLen: 5, MaxLen: 11, ExcludeAmbiguous: true})
+	require.NoError(t, err)
+
+	for i := 0; i < 100; i++ {
+		id, err := p.Generate()
+		require.NoError(t, err)
+		require.Len(t, id, 7)
+		require.False(t, strings.ContainsAny(id, Ambiguous), id)
+		require.True(t, p.Valid(id))
+	}
+	require.True(t, p.Valid("0O1lI"), "excluded characters stay valid for existing IDs")
+}
+
+func TestPolicyBlocklist(t *testing.T) {
+	p, err := NewPolicy(PolicyParams{Alphabet: "abs", Len: 3, Blocklist: []string{"ass"}})
+	require.NoError(t, err)
+
+	require.True(t, p.Blocked("bASs"))
+	require.True(t, p.Blocked("a55"))
+	for i := 0; i < 100; i++ {
+		id, err := p.Generate()
+		require.NoError(t, err)
+		require.NotContains(t, id, "ass")
+	}
+
+	require.NoError(t, p.SetBlocklist(nil))
+	require.False(t, p.Blocked("ass"))
+}
+
+func TestPolicyBlocklistRejects(t *testing.T) {
+	for _, word := range []string{"a", "4", "a-", "_0_"} {
+		_, err := NewPolicy(PolicyParams{Alphabet: "abo", Len: 3, Blocklist: []string{"bob", word}})
+		require.ErrorIs(t, err, ErrInvalidPolicy, word)
+	}
+
+	p, err := NewPolicy(PolicyParams{Alphabet: "abo", Len: 3, Blocklist: []string{"bob", "-"}})
+	require.NoError(t, err, "words that normalize to nothing are ignored")
+	require.ErrorIs(t, p.SetBlocklist([]string{"o"}), ErrInvalidPolicy)
+	require.True(t, p.Blocked("bob"), "a rejected blocklist keeps the previous one")
+	require.False(t, p.Blocked("abo"))
+}
+
+func TestPolicyGenerateBlocked(t *testing.T) {
+	p, err := NewPolicy(PolicyParams{Alphabet: "ab", Len: 2, Blocklist: []string{"aa", "ab", "ba", "bb"}})
+	require.NoError(t, err)
+
+	_, err = p.Generate()
+	require.ErrorIs(t, err, ErrBlocked)
+}
@@ -0,0 +1,46 @@
+package signature
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	signer := NewSigner([]byte("0123456789abcdef0123456789abcdef"))
+	signer.now = func() time.Time { return now }
+	other := NewSigner([]byte("fedcba9876543210fedcba9876543210"))
+	other.now = signer.now
+
+	token := signer.Sign("abc", time.Hour)
+	exp, mac, _ := strings.Cut(token, ".")
+	tampered := []byte(mac)
+	tampered[0] ^= 1
+
+	tests := []struct {
+		name  string
+		token string
+		value string
+		now   time.Time
+		ok    bool
+	}{
+		{name: "valid", token: token, value: "abc", now: now, ok: true},
+		{name: "other value", token: token, value: "abd", now: now},
+		{name: "expired", token: token, value: "abc", now: now.Add(time.Hour)},
+		{name: "tampered mac", token: exp + "." + string(tampered), value: "abc", now: now},
+		{name: "extended expiry", token: strconv.FormatInt(now.Add(2*time.Hour).Unix(), 10) + "." + mac, value: "abc", now: now.Add(time.Hour)},
+		{name: "wrong secret", token: other.Sign("abc", time.Hour), value: "abc", now: now},
+		{name: "no separator", token: exp + mac, value: "abc", now: now},
+		{name: "non-numeric expiry", token: "soon." + mac, value: "abc", now: now},
+		{name: "invalid base64", token: exp + ".!!!", value: "abc", now: now},
+		{name: "empty", token: "", value: "abc", now: now},
+	}
+	for _, tt := range tests {
+		now = tt.now
+		require.Equal(t, tt.ok, signer.Verify(tt.token, tt.value), tt.name)
+	}
+}
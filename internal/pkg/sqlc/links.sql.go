@@ -7,21 +7,74 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
 )
 
+const countShortIDsByLength = `-- name: CountShortIDsByLength :one
+SELECT ((SELECT COUNT(*) FROM "links" WHERE length("short_id") = $1::int)
+	+ (SELECT COUNT(*) FROM "short_id_tombstones" WHERE length("short_id") = $1::int))::bigint AS "count"
+`
+
+func (q *Queries) CountShortIDsByLength(ctx context.Context, length int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countShortIDsByLength, length)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countWorkspaceLinks = `-- name: CountWorkspaceLinks :one
+SELECT COUNT(*) FROM "links" WHERE "workspace_id" = $1 AND "deleted_at" IS NULL
+`
+
+func (q *Queries) CountWorkspaceLinks(ctx context.Context, workspaceID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countWorkspaceLinks, workspaceID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createLink = `-- name: CreateLink :one
-INSERT INTO "links" ("short_id", "href") 
-VALUES ($1, $2) 
-RETURNING id, short_id, href, created_at, usage_count, usage_at
+INSERT INTO "links" ("short_id", "href", "password_hash", "reusable", "max_clicks", "rules", "variants", "sticky_variants", "query_options", "title", "description", "tags", "metadata", "workspace_id") 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) 
+RETURNING id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id
 `
 
 type CreateLinkParams struct {
-	ShortID string
-	Href    string
+	ShortID        string
+	Href           string
+	PasswordHash   sql.NullString
+	Reusable       bool
+	MaxClicks      sql.NullInt64
+	Rules          json.RawMessage
+	Variants       json.RawMessage
+	StickyVariants bool
+	QueryOptions   json.RawMessage
+	Title          string
+	Description    string
+	Tags           []string
+	Metadata       json.RawMessage
+	WorkspaceID    int64
 }
 
 func (q *Queries) CreateLink(ctx context.Context, arg CreateLinkParams) (Link, error) {
-	row := q.db.QueryRowContext(ctx, createLink, arg.ShortID, arg.Href)
+	row := q.db.QueryRow(ctx, createLink,
+		arg.ShortID,
+		arg.Href,
+		arg.PasswordHash,
+		arg.Reusable,
+		arg.MaxClicks,
+		arg.Rules,
+		arg.Variants,
+		arg.StickyVariants,
+		arg.QueryOptions,
+		arg.Title,
+		arg.Description,
+		arg.Tags,
+		arg.Metadata,
+		arg.WorkspaceID,
+	)
 	var i Link
 	err := row.Scan(
 		&i.ID,
@@ -30,16 +83,29 @@ func (q *Queries) CreateLink(ctx context.Context, arg CreateLinkParams) (Link, e
 		&i.CreatedAt,
 		&i.UsageCount,
 		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
 	)
 	return i, err
 }
 
-const getLinkByHref = `-- name: GetLinkByHref :one
-SELECT id, short_id, href, created_at, usage_count, usage_at FROM "links" WHERE "href" = $1
+const getLinkByShortID = `-- name: GetLinkByShortID :one
+SELECT id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id FROM "links" WHERE "short_id" = $1
 `
 
-func (q *Queries) GetLinkByHref(ctx context.Context, href string) (Link, error) {
-	row := q.db.QueryRowContext(ctx, getLinkByHref, href)
+func (q *Queries) GetLinkByShortID(ctx context.Context, shortID string) (Link, error) {
+	row := q.db.QueryRow(ctx, getLinkByShortID, shortID)
 	var i Link
 	err := row.Scan(
 		&i.ID,
@@ -48,16 +114,34 @@ func (q *Queries) GetLinkByHref(ctx context.Context, href string) (Link, error)
 		&i.CreatedAt,
 		&i.UsageCount,
 		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
 	)
 	return i, err
 }
 
-const getLinkByShortID = `-- name: GetLinkByShortID :one
-SELECT id, short_id, href, created_at, usage_count, usage_at FROM "links" WHERE "short_id" = $1
+const getLinkByShortIDForUpdate = `-- name: GetLinkByShortIDForUpdate :one
+SELECT id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id FROM "links" WHERE "workspace_id" = $1 AND "short_id" = $2 FOR UPDATE
 `
 
-func (q *Queries) GetLinkByShortID(ctx context.Context, shortID string) (Link, error) {
-	row := q.db.QueryRowContext(ctx, getLinkByShortID, shortID)
+type GetLinkByShortIDForUpdateParams struct {
+	WorkspaceID int64
+	ShortID     string
+}
+
+func (q *Queries) GetLinkByShortIDForUpdate(ctx context.Context, arg GetLinkByShortIDForUpdateParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getLinkByShortIDForUpdate, arg.WorkspaceID, arg.ShortID)
 	var i Link
 	err := row.Scan(
 		&i.ID,
@@ -66,28 +150,482 @@ func (q *Queries) GetLinkByShortID(ctx context.Context, shortID string) (Link, e
 		&i.CreatedAt,
 		&i.UsageCount,
 		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
 	)
 	return i, err
 }
 
-const isLinkExistByShortID = `-- name: IsLinkExistByShortID :one
+const getReusableLinkByHref = `-- name: GetReusableLinkByHref :one
+SELECT id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id FROM "links" WHERE "workspace_id" = $1 AND "href" = $2 AND "reusable"
+`
+
+type GetReusableLinkByHrefParams struct {
+	WorkspaceID int64
+	Href        string
+}
+
+func (q *Queries) GetReusableLinkByHref(ctx context.Context, arg GetReusableLinkByHrefParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getReusableLinkByHref, arg.WorkspaceID, arg.Href)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.ShortID,
+		&i.Href,
+		&i.CreatedAt,
+		&i.UsageCount,
+		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const getWorkspaceLinkByShortID = `-- name: GetWorkspaceLinkByShortID :one
+SELECT id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id FROM "links" WHERE "workspace_id" = $1 AND "short_id" = $2
+`
+
+type GetWorkspaceLinkByShortIDParams struct {
+	WorkspaceID int64
+	ShortID     string
+}
+
+func (q *Queries) GetWorkspaceLinkByShortID(ctx context.Context, arg GetWorkspaceLinkByShortIDParams) (Link, error) {
+	row := q.db.QueryRow(ctx, getWorkspaceLinkByShortID, arg.WorkspaceID, arg.ShortID)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.ShortID,
+		&i.Href,
+		&i.CreatedAt,
+		&i.UsageCount,
+		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+type ImportLinksParams struct {
+	WorkspaceID int64
+	ShortID     string
+	Href        string
+}
+
+const isShortIDInWorkspace = `-- name: IsShortIDInWorkspace :one
+SELECT EXISTS(SELECT 1 FROM "links" WHERE "workspace_id" = $1 AND "short_id" = $2)
+	OR EXISTS(SELECT 1 FROM "short_id_tombstones" WHERE "workspace_id" = $1 AND "short_id" = $2)
+`
+
+type IsShortIDInWorkspaceParams struct {
+	WorkspaceID int64
+	ShortID     string
+}
+
+func (q *Queries) IsShortIDInWorkspace(ctx context.Context, arg IsShortIDInWorkspaceParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isShortIDInWorkspace, arg.WorkspaceID, arg.ShortID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const isShortIDTaken = `-- name: IsShortIDTaken :one
 SELECT EXISTS(SELECT 1 FROM "links" WHERE "short_id" = $1)
+	OR EXISTS(SELECT 1 FROM "short_id_tombstones" WHERE "short_id" = $1)
+`
+
+func (q *Queries) IsShortIDTaken(ctx context.Context, shortID string) (bool, error) {
+	row := q.db.QueryRow(ctx, isShortIDTaken, shortID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listLinks = `-- name: ListLinks :many
+SELECT id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id FROM "links" 
+WHERE "workspace_id" = $1 AND "deleted_at" IS NULL AND "tags" @> $2::text[] AND "metadata" @> $3::jsonb 
+	AND ($4::bigint IS NULL OR "id" < $4) 
+ORDER BY "id" DESC 
+LIMIT $5
+`
+
+type ListLinksParams struct {
+	WorkspaceID int64
+	Tags        []string
+	Metadata    json.RawMessage
+	BeforeID    sql.NullInt64
+	MaxResults  int32
+}
+
+func (q *Queries) ListLinks(ctx context.Context, arg ListLinksParams) ([]Link, error) {
+	rows, err := q.db.Query(ctx, listLinks,
+		arg.WorkspaceID,
+		arg.Tags,
+		arg.Metadata,
+		arg.BeforeID,
+		arg.MaxResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.ShortID,
+			&i.Href,
+			&i.CreatedAt,
+			&i.UsageCount,
+			&i.UsageAt,
+			&i.PasswordHash,
+			&i.Reusable,
+			&i.MaxClicks,
+			&i.Rules,
+			&i.Variants,
+			&i.StickyVariants,
+			&i.QueryOptions,
+			&i.DeletedAt,
+			&i.Title,
+			&i.Description,
+			&i.Tags,
+			&i.Metadata,
+			&i.WorkspaceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLinksByShortIDs = `-- name: ListLinksByShortIDs :many
+SELECT id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id FROM "links" WHERE "short_id" = ANY($1::text[])
+`
+
+func (q *Queries) ListLinksByShortIDs(ctx context.Context, shortIds []string) ([]Link, error) {
+	rows, err := q.db.Query(ctx, listLinksByShortIDs, shortIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.ShortID,
+			&i.Href,
+			&i.CreatedAt,
+			&i.UsageCount,
+			&i.UsageAt,
+			&i.PasswordHash,
+			&i.Reusable,
+			&i.MaxClicks,
+			&i.Rules,
+			&i.Variants,
+			&i.StickyVariants,
+			&i.QueryOptions,
+			&i.DeletedAt,
+			&i.Title,
+			&i.Description,
+			&i.Tags,
+			&i.Metadata,
+			&i.WorkspaceID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+SELECT "tag"::text AS "tag", COUNT(*) AS "links" 
+FROM "links", unnest("tags") AS "tag" 
+WHERE "workspace_id" = $1 AND "deleted_at" IS NULL 
+GROUP BY "tag" 
+ORDER BY "links" DESC, "tag"
+`
+
+type ListTagsRow struct {
+	Tag   string
+	Links int64
+}
+
+func (q *Queries) ListTags(ctx context.Context, workspaceID int64) ([]ListTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTags, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagsRow
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(&i.Tag, &i.Links); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTakenShortIDs = `-- name: ListTakenShortIDs :many
+SELECT "short_id" FROM "links" WHERE "short_id" = ANY($1::text[])
+UNION ALL
+SELECT "short_id" FROM "short_id_tombstones" WHERE "short_id" = ANY($1::text[])
+`
+
+func (q *Queries) ListTakenShortIDs(ctx context.Context, shortIds []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listTakenShortIDs, shortIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var short_id string
+		if err := rows.Scan(&short_id); err != nil {
+			return nil, err
+		}
+		items = append(items, short_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedLinks = `-- name: PurgeDeletedLinks :execrows
+WITH "purged" AS (
+	DELETE FROM "links"
+	WHERE "id" IN (
+		SELECT "id" FROM "links"
+		WHERE "deleted_at" < $1::timestamptz
+		ORDER BY "deleted_at"
+		LIMIT $2::int
+	)
+	RETURNING "id", "short_id", "deleted_at", "workspace_id"
+), "tombstoned" AS (
+	INSERT INTO "short_id_tombstones" ("short_id", "deleted_at", "workspace_id")
+	SELECT "short_id", "deleted_at", "workspace_id" FROM "purged"
+	ON CONFLICT DO NOTHING
+)
+INSERT INTO "audit_log" ("link_id", "short_id", "action", "actor_type", "actor_id", "workspace_id")
+SELECT "id", "short_id", $3::text, $4::text, $5::text, "workspace_id" FROM "purged"
 `
 
-func (q *Queries) IsLinkExistByShortID(ctx context.Context, shortID string) (bool, error) {
-	row := q.db.QueryRowContext(ctx, isLinkExistByShortID, shortID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+type PurgeDeletedLinksParams struct {
+	Before    time.Time
+	BatchSize int32
+	Action    string
+	ActorType string
+	ActorID   string
 }
 
-const updateLinkUsageInfo = `-- name: UpdateLinkUsageInfo :exec
+func (q *Queries) PurgeDeletedLinks(ctx context.Context, arg PurgeDeletedLinksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeDeletedLinks,
+		arg.Before,
+		arg.BatchSize,
+		arg.Action,
+		arg.ActorType,
+		arg.ActorID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const restoreLink = `-- name: RestoreLink :one
+UPDATE "links"
+SET "deleted_at" = NULL
+WHERE "id" = $1
+RETURNING id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id
+`
+
+func (q *Queries) RestoreLink(ctx context.Context, id int64) (Link, error) {
+	row := q.db.QueryRow(ctx, restoreLink, id)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.ShortID,
+		&i.Href,
+		&i.CreatedAt,
+		&i.UsageCount,
+		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const softDeleteLink = `-- name: SoftDeleteLink :one
+UPDATE "links"
+SET "deleted_at" = NOW(), "reusable" = false
+WHERE "id" = $1
+RETURNING id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id
+`
+
+func (q *Queries) SoftDeleteLink(ctx context.Context, id int64) (Link, error) {
+	row := q.db.QueryRow(ctx, softDeleteLink, id)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.ShortID,
+		&i.Href,
+		&i.CreatedAt,
+		&i.UsageCount,
+		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const updateLink = `-- name: UpdateLink :one
 UPDATE "links" 
-SET "usage_count" = "usage_count" + 1, "usage_at" = NOW()
+SET "href" = $2, "password_hash" = $3, "reusable" = false, "max_clicks" = $4, "rules" = $5, "variants" = $6, "sticky_variants" = $7, "query_options" = $8, 
+	"title" = $9, "description" = $10, "tags" = $11, "metadata" = $12
 WHERE "id" = $1
+RETURNING id, short_id, href, created_at, usage_count, usage_at, password_hash, reusable, max_clicks, rules, variants, sticky_variants, query_options, deleted_at, title, description, tags, metadata, workspace_id
+`
+
+type UpdateLinkParams struct {
+	ID             int64
+	Href           string
+	PasswordHash   sql.NullString
+	MaxClicks      sql.NullInt64
+	Rules          json.RawMessage
+	Variants       json.RawMessage
+	StickyVariants bool
+	QueryOptions   json.RawMessage
+	Title          string
+	Description    string
+	Tags           []string
+	Metadata       json.RawMessage
+}
+
+func (q *Queries) UpdateLink(ctx context.Context, arg UpdateLinkParams) (Link, error) {
+	row := q.db.QueryRow(ctx, updateLink,
+		arg.ID,
+		arg.Href,
+		arg.PasswordHash,
+		arg.MaxClicks,
+		arg.Rules,
+		arg.Variants,
+		arg.StickyVariants,
+		arg.QueryOptions,
+		arg.Title,
+		arg.Description,
+		arg.Tags,
+		arg.Metadata,
+	)
+	var i Link
+	err := row.Scan(
+		&i.ID,
+		&i.ShortID,
+		&i.Href,
+		&i.CreatedAt,
+		&i.UsageCount,
+		&i.UsageAt,
+		&i.PasswordHash,
+		&i.Reusable,
+		&i.MaxClicks,
+		&i.Rules,
+		&i.Variants,
+		&i.StickyVariants,
+		&i.QueryOptions,
+		&i.DeletedAt,
+		&i.Title,
+		&i.Description,
+		&i.Tags,
+		&i.Metadata,
+		&i.WorkspaceID,
+	)
+	return i, err
+}
+
+const updateLinkUsageInfo = `-- name: UpdateLinkUsageInfo :one
+UPDATE "links" 
+SET "usage_count" = "usage_count" + 1, "usage_at" = NOW()
+WHERE "id" = $1 AND ("max_clicks" IS NULL OR "usage_count" < "max_clicks")
+RETURNING "usage_count", "deleted_at" IS NOT NULL AS "deleted"
 `
 
-func (q *Queries) UpdateLinkUsageInfo(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, updateLinkUsageInfo, id)
-	return err
+type UpdateLinkUsageInfoRow struct {
+	UsageCount int64
+	Deleted    bool
+}
+
+func (q *Queries) UpdateLinkUsageInfo(ctx context.Context, id int64) (UpdateLinkUsageInfoRow, error) {
+	row := q.db.QueryRow(ctx, updateLinkUsageInfo, id)
+	var i UpdateLinkUsageInfoRow
+	err := row.Scan(&i.UsageCount, &i.Deleted)
+	return i, err
 }
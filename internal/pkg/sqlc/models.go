@@ -5,14 +5,119 @@
 package sqlc
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+type ApiKey struct {
+	ID          int64
+	WorkspaceID int64
+	Name        string
+	Role        string
+	KeyHash     string
+	CreatedAt   time.Time
+}
+
+type AuditLog struct {
+	ID          int64
+	LinkID      int64
+	ShortID     string
+	Action      string
+	ActorType   string
+	ActorID     string
+	OldValue    json.RawMessage
+	NewValue    json.RawMessage
+	CreatedAt   time.Time
+	WorkspaceID int64
+}
+
+type Event struct {
+	ID           int64
+	Type         string
+	LinkID       int64
+	Payload      json.RawMessage
+	CreatedAt    time.Time
+	DispatchedAt sql.NullTime
+	PublishedAt  sql.NullTime
+	WorkspaceID  int64
+}
+
 type Link struct {
-	ID         int64
-	ShortID    string
-	Href       string
-	CreatedAt  time.Time
-	UsageCount int64
-	UsageAt    time.Time
+	ID             int64
+	ShortID        string
+	Href           string
+	CreatedAt      time.Time
+	UsageCount     int64
+	UsageAt        time.Time
+	PasswordHash   sql.NullString
+	Reusable       bool
+	MaxClicks      sql.NullInt64
+	Rules          json.RawMessage
+	Variants       json.RawMessage
+	StickyVariants bool
+	QueryOptions   json.RawMessage
+	DeletedAt      sql.NullTime
+	Title          string
+	Description    string
+	Tags           []string
+	Metadata       json.RawMessage
+	WorkspaceID    int64
+}
+
+type LinkClick struct {
+	ID          int64
+	LinkID      int64
+	Variant     sql.NullString
+	CreatedAt   time.Time
+	WorkspaceID int64
+}
+
+type ShortIDTombstone struct {
+	ShortID     string
+	DeletedAt   time.Time
+	PurgedAt    time.Time
+	WorkspaceID int64
+}
+
+type Webhook struct {
+	ID          int64
+	Url         string
+	Secret      string
+	EventTypes  []string
+	CreatedAt   time.Time
+	WorkspaceID int64
+}
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     int64
+	EventID       int64
+	Status        string
+	Attempts      int32
+	NextAttemptAt time.Time
+	LastError     sql.NullString
+	DeliveredAt   sql.NullTime
+	CreatedAt     time.Time
+}
+
+type Workspace struct {
+	ID        int64
+	Slug      string
+	Name      string
+	MaxLinks  sql.NullInt64
+	CreatedAt time.Time
+}
+
+type WorkspaceDomain struct {
+	Domain      string
+	WorkspaceID int64
+	CreatedAt   time.Time
+}
+
+type WorkspaceMember struct {
+	WorkspaceID int64
+	UserID      string
+	Role        string
+	CreatedAt   time.Time
 }
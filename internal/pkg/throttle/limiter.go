@@ -0,0 +1,76 @@
+package throttle
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows at most max attempts per key within a fixed window.
+type Limiter struct {
+	mu      sync.Mutex
+	max     int
+	window  time.Duration
+	now     func() time.Time
+	entries map[string]*entry
+	sweptAt time.Time
+}
+
+type entry struct {
+	count   int
+	resetAt time.Time
+}
+
+func NewLimiter(max int, window time.Duration) *Limiter {
+	return &Limiter{
+		max:     max,
+		window:  window,
+		now:     time.Now,
+		entries: make(map[string]*entry),
+	}
+}
+
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	e, ok := l.entries[key]
+	if !ok || !now.Before(e.resetAt) {
+		l.sweep(now)
+		e = &entry{resetAt: now.Add(l.window)}
+		l.entries[key] = e
+	}
+	if e.count >= l.max {
+		return false
+	}
+	e.count++
+	return true
+}
+
+// SetLimit changes the limit for windows opened from now on.
+func (l *Limiter) SetLimit(max int, window time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.max = max
+	l.window = window
+}
+
+func (l *Limiter) Reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.entries, key)
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < l.window {
+		return
+	}
+	l.sweptAt = now
+	for k, e := range l.entries {
+		if !now.Before(e.resetAt) {
+			delete(l.entries, k)
+		}
+	}
+}
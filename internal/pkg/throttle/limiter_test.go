@@ -0,0 +1,93 @@
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllow(t *testing.T) {
+	type step struct {
+		after   time.Duration
+		key     string
+		setMax  int
+		allowed bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "limit within the window",
+			steps: []step{
+				{key: "a", allowed: true},
+				{key: "a", allowed: true},
+				{key: "a", allowed: false},
+				{key: "b", allowed: true},
+			},
+		},
+		{
+			name: "window rollover",
+			steps: []step{
+				{key: "a", allowed: true},
+				{key: "a", allowed: true},
+				{after: 59 * time.Second, key: "a", allowed: false},
+				{after: time.Second, key: "a", allowed: true},
+				{key: "a", allowed: true},
+				{key: "a", allowed: false},
+			},
+		},
+		{
+			name: "lower limit mid-window",
+			steps: []step{
+				{key: "a", allowed: true},
+				{setMax: 1, key: "a", allowed: false},
+				{after: time.Minute, key: "a", allowed: true},
+				{key: "a", allowed: false},
+			},
+		},
+		{
+			name: "higher limit mid-window",
+			steps: []step{
+				{key: "a", allowed: true},
+				{key: "a", allowed: true},
+				{setMax: 3, key: "a", allowed: true},
+				{key: "a", allowed: false},
+			},
+		},
+	}
+	for _, tt := range tests {
+		now := time.Unix(1_700_000_000, 0)
+		l := NewLimiter(2, time.Minute)
+		l.now = func() time.Time { return now }
+		for i, s := range tt.steps {
+			now = now.Add(s.after)
+			if s.setMax != 0 {
+				l.SetLimit(s.setMax, time.Minute)
+			}
+			require.Equal(t, s.allowed, l.Allow(s.key), "%s: step %d", tt.name, i)
+		}
+	}
+}
+
+func TestSetLimitWindow(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	l := NewLimiter(1, time.Minute)
+	l.now = func() time.Time { return now }
+
+	require.True(t, l.Allow("a"))
+	l.SetLimit(1, time.Hour)
+	now = now.Add(time.Minute)
+	require.True(t, l.Allow("a"), "the open window keeps its end")
+	now = now.Add(time.Minute)
+	require.False(t, l.Allow("a"), "new windows use the new length")
+}
+
+func TestReset(t *testing.T) {
+	l := NewLimiter(1, time.Minute)
+	require.True(t, l.Allow("a"))
+	require.False(t, l.Allow("a"))
+	l.Reset("a")
+	require.True(t, l.Allow("a"))
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	ActorAnonymous = "anonymous"
+	ActorUser      = "user"
+	ActorAPIKey    = "api_key"
+	ActorSystem    = "system"
+)
+
+// Actor is who a change is attributed to in the audit log.
+type Actor struct {
+	Type string
+	ID   string
+}
+
+// APIKeyActor identifies an API key by a fingerprint so that the key itself
+// is never stored.
+func APIKeyActor(key string) Actor {
+	sum := sha256.Sum256([]byte(key))
+	return Actor{Type: ActorAPIKey, ID: hex.EncodeToString(sum[:8])}
+}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor of ctx, anonymous when none was set.
+func ActorFrom(ctx context.Context) Actor {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	if !ok {
+		return Actor{Type: ActorAnonymous}
+	}
+	return actor
+}
@@ -2,7 +2,15 @@ package usecase
 
 import "errors"
 
-var ErrNoResult = errors.New("no result error")
+var (
+	ErrNoResult        = errors.New("no result error")
+	ErrGone            = errors.New("gone error")
+	ErrUnauthorized    = errors.New("unauthorized error")
+	ErrForbidden       = errors.New("forbidden error")
+	ErrConflict        = errors.New("conflict error")
+	ErrTooManyRequests = errors.New("too many requests error")
+	ErrUnavailable     = errors.New("unavailable error")
+)
 
 type ErrValidation struct {
 	message string
@@ -20,3 +28,31 @@ func (e ErrValidation) Error() string {
 func (e ErrValidation) Unwrap() error {
 	return e.err
 }
+
+// Error refines one of the base errors above with a stable code that
+// transports expose to clients.
+type Error struct {
+	base error
+	code string
+	msg  string
+}
+
+func NewError(base error, code string, msg string) *Error {
+	return &Error{base: base, code: code, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.base.Error() + ": " + e.msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.base
+}
+
+func (e *Error) Code() string {
+	return e.code
+}
+
+func (e *Error) Message() string {
+	return e.msg
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+)
+
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// DefaultWorkspaceID is the workspace created with the schema. It owns what
+// existed before workspaces, and its admins manage the other workspaces.
+const DefaultWorkspaceID int64 = 1
+
+var roleRanks = map[string]int{
+	RoleViewer: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// Principal is who a request acts as: the actor, the workspace it acts in
+// and its role there. An empty Role grants nothing.
+type Principal struct {
+	Actor       Actor
+	WorkspaceID int64
+	Role        string
+}
+
+// HigherRole returns the higher of two roles.
+func HigherRole(a, b string) string {
+	if roleRanks[b] > roleRanks[a] {
+		return b
+	}
+	return a
+}
+
+// Can reports whether the principal has at least role.
+func (p Principal) Can(role string) bool {
+	return roleRanks[p.Role] >= roleRanks[role]
+}
+
+// Credentials are what a transport knows about the caller.
+type Credentials struct {
+	User      string
+	Token     string
+	APIKey    string
+	Workspace string
+	ClientIP  string
+}
+
+// Identity is what a verified bearer token says about the caller: the user
+// and the roles granted by the identity provider, as listed in the token.
+type Identity struct {
+	User  string
+	Roles []string
+}
+
+// TokenVerifier checks bearer tokens. Tokens that are malformed, expired or
+// not signed by a trusted key are reported with ErrUnauthorized.
+type TokenVerifier interface {
+	VerifyToken(ctx context.Context, token string) (Identity, error)
+}
+
+type Authenticator interface {
+	Authenticate(ctx context.Context, credentials Credentials) (Principal, error)
+}
+
+type principalKey struct{}
+
+// WithPrincipal stores the principal in ctx, its actor included.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(WithActor(ctx, principal.Actor), principalKey{}, principal)
+}
+
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// Authorize returns the principal of ctx if it has at least role. Anonymous
+// callers are told to authenticate, the others that they are not allowed.
+func Authorize(ctx context.Context, role string) (Principal, error) {
+	principal, ok := PrincipalFrom(ctx)
+	if !ok {
+		return Principal{}, ErrUnauthorized
+	}
+	if !principal.Can(role) {
+		if principal.Actor.Type == ActorAnonymous {
+			return Principal{}, ErrUnauthorized
+		}
+		return Principal{}, ErrForbidden
+	}
+	return principal, nil
+}
+
+// AuthorizeOperator allows the admins of the default workspace, who manage
+// the deployment as a whole.
+func AuthorizeOperator(ctx context.Context) (Principal, error) {
+	principal, err := Authorize(ctx, RoleAdmin)
+	if err != nil {
+		return Principal{}, err
+	}
+	if principal.WorkspaceID != DefaultWorkspaceID {
+		return Principal{}, ErrForbidden
+	}
+	return principal, nil
+}
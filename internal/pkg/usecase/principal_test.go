@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorize(t *testing.T) {
+	user := Actor{Type: ActorUser, ID: "alice"}
+	anonymous := Actor{Type: ActorAnonymous, ID: "192.0.2.1"}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		role      string
+		err       error
+	}{
+		{name: "no principal", role: RoleViewer, err: ErrUnauthorized},
+		{name: "admin as editor", principal: &Principal{Actor: user, WorkspaceID: 2, Role: RoleAdmin}, role: RoleEditor},
+		{name: "viewer as editor", principal: &Principal{Actor: user, WorkspaceID: 2, Role: RoleViewer}, role: RoleEditor, err: ErrForbidden},
+		{name: "not a member", principal: &Principal{Actor: user, WorkspaceID: 2}, role: RoleViewer, err: ErrForbidden},
+		{name: "anonymous without role", principal: &Principal{Actor: anonymous, WorkspaceID: DefaultWorkspaceID}, role: RoleViewer, err: ErrUnauthorized},
+		{name: "anonymous editor", principal: &Principal{Actor: anonymous, WorkspaceID: DefaultWorkspaceID, Role: RoleEditor}, role: RoleEditor},
+	}
+	for _, tt := range tests {
+		ctx := context.Background()
+		if tt.principal != nil {
+			ctx = WithPrincipal(ctx, *tt.principal)
+		}
+		principal, err := Authorize(ctx, tt.role)
+		require.ErrorIs(t, err, tt.err, tt.name)
+		if tt.err == nil {
+			require.Equal(t, *tt.principal, principal, tt.name)
+			require.Equal(t, tt.principal.Actor, ActorFrom(ctx), tt.name)
+		}
+	}
+}
+
+func TestAuthorizeOperator(t *testing.T) {
+	admin := Principal{Actor: Actor{Type: ActorUser, ID: "alice"}, WorkspaceID: DefaultWorkspaceID, Role: RoleAdmin}
+	_, err := AuthorizeOperator(WithPrincipal(context.Background(), admin))
+	require.NoError(t, err)
+
+	admin.WorkspaceID = 2
+	_, err = AuthorizeOperator(WithPrincipal(context.Background(), admin))
+	require.ErrorIs(t, err, ErrForbidden)
+}
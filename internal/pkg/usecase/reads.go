@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type primaryReadsKey struct{}
+
+// WithPrimaryReads makes GetReadRepo use the primary for ctx.
+func WithPrimaryReads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryReadsKey{}, true)
+}
+
+func PrimaryReads(ctx context.Context) bool {
+	v, _ := ctx.Value(primaryReadsKey{}).(bool)
+	return v
+}
+
+// RecentWrites remembers keys written during the last window so that reads of
+// them can go to the primary until replicas catch up. It is per process; a nil
+// RecentWrites remembers nothing.
+type RecentWrites struct {
+	mu        sync.Mutex
+	window    time.Duration
+	until     map[string]time.Time
+	nextPrune time.Time
+	now       func() time.Time
+}
+
+func NewRecentWrites(window time.Duration) *RecentWrites {
+	if window <= 0 {
+		return nil
+	}
+	return &RecentWrites{
+		window: window,
+		until:  make(map[string]time.Time),
+		now:    time.Now,
+	}
+}
+
+func (r *RecentWrites) Add(key string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if !now.Before(r.nextPrune) {
+		for k, until := range r.until {
+			if !now.Before(until) {
+				delete(r.until, k)
+			}
+		}
+		r.nextPrune = now.Add(r.window)
+	}
+	r.until[key] = now.Add(r.window)
+}
+
+func (r *RecentWrites) Contains(key string) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	until, ok := r.until[key]
+	return ok && r.now().Before(until)
+}
+
+// ReadContext returns ctx routed to the primary when key was written recently.
+func (r *RecentWrites) ReadContext(ctx context.Context, key string) context.Context {
+	if r.Contains(key) {
+		return WithPrimaryReads(ctx)
+	}
+	return ctx
+}
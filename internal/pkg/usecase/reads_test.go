@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecentWrites(t *testing.T) {
+	r := require.New(t)
+
+	now := time.Now()
+	writes := NewRecentWrites(time.Second)
+	writes.now = func() time.Time { return now }
+
+	writes.Add("abc")
+	r.True(writes.Contains("abc"))
+	r.False(writes.Contains("xyz"))
+	r.True(PrimaryReads(writes.ReadContext(context.Background(), "abc")))
+	r.False(PrimaryReads(writes.ReadContext(context.Background(), "xyz")))
+
+	now = now.Add(time.Second)
+	r.False(writes.Contains("abc"))
+
+	writes.Add("xyz")
+	r.NotContains(writes.until, "abc")
+
+	var disabled *RecentWrites = NewRecentWrites(0)
+	disabled.Add("abc")
+	r.False(disabled.Contains("abc"))
+}
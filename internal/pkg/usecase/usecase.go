@@ -4,5 +4,7 @@ import "context"
 
 type RepoFactory[T any] interface {
 	GetRepo() T
+	// GetReadRepo returns a repo for reads that tolerate replication lag.
+	GetReadRepo(ctx context.Context) T
 	InTransaction(ctx context.Context, txFn func(r T) error) error
 }
@@ -0,0 +1,103 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"syscall"
+)
+
+var ErrAddressNotAllowed = errors.New("address not allowed")
+
+// nonPublic are the special-purpose ranges that netip.Addr has no predicate
+// for: this network, shared address space (CGNAT), IETF protocol
+// assignments, benchmarking, reserved and broadcast, and the IPv6 prefixes
+// that embed an IPv4 address.
+var nonPublic = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// AddressPolicy decides which hosts webhooks may be delivered to: public
+// addresses, and the Allowed networks of intentionally internal receivers.
+// Loopback, private, link-local (including 169.254.169.254, the cloud
+// metadata service) and other special-purpose addresses are refused, so that
+// workspace admins cannot make the service call internal hosts.
+type AddressPolicy struct {
+	allowed []netip.Prefix
+}
+
+func NewAddressPolicy(allowed []netip.Prefix) *AddressPolicy {
+	return &AddressPolicy{allowed: allowed}
+}
+
+func (p *AddressPolicy) Allows(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range p.allowed {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return isPublic(addr)
+}
+
+func isPublic(addr netip.Addr) bool {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublic {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL resolves the host of rawURL and fails unless all its addresses
+// are allowed.
+func (p *AddressPolicy) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("url.Parse: %w", err)
+	}
+	host := u.Hostname()
+	addrs := []netip.Addr{}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = append(addrs, addr)
+	} else {
+		addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return fmt.Errorf("resolve %s: %w", host, err)
+		}
+	}
+	for _, addr := range addrs {
+		if !p.Allows(addr) {
+			return fmt.Errorf("%s resolves to %s: %w", host, addr.Unmap(), ErrAddressNotAllowed)
+		}
+	}
+	return nil
+}
+
+// Control is a net.Dialer Control function refusing connections to
+// addresses the policy does not allow. It runs after name resolution, for
+// the address actually dialed, so a host resolving to another address at
+// delivery time than at registration is still refused.
+func (p *AddressPolicy) Control(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("netip.ParseAddrPort: %w", err)
+	}
+	if !p.Allows(addrPort.Addr()) {
+		return fmt.Errorf("dial %s: %w", addrPort.Addr().Unmap(), ErrAddressNotAllowed)
+	}
+	return nil
+}
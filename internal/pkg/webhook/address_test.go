@@ -0,0 +1,40 @@
+package webhook
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressPolicyCheckURL(t *testing.T) {
+	policy := NewAddressPolicy([]netip.Prefix{netip.MustParsePrefix("10.20.0.0/16")})
+	tests := []struct {
+		url     string
+		allowed bool
+	}{
+		{url: "https://93.184.216.34/hook", allowed: true},
+		{url: "https://[2606:4700::1111]/hook", allowed: true},
+		{url: "http://10.20.1.2:8080/hook", allowed: true},
+		{url: "http://10.0.0.1/hook"},
+		{url: "http://192.168.1.1/hook"},
+		{url: "http://127.0.0.1/hook"},
+		{url: "http://localhost/hook"},
+		{url: "http://169.254.169.254/latest/meta-data/"},
+		{url: "http://100.64.0.1/hook"},
+		{url: "http://0.0.0.0/hook"},
+		{url: "http://[::1]/hook"},
+		{url: "http://[::ffff:127.0.0.1]/hook"},
+		{url: "http://[fd00::1]/hook"},
+		{url: "http://[fe80::1]/hook"},
+	}
+	for _, tt := range tests {
+		err := policy.CheckURL(context.Background(), tt.url)
+		if tt.allowed {
+			require.NoError(t, err, tt.url)
+		} else {
+			require.ErrorIs(t, err, ErrAddressNotAllowed, tt.url)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var loopback = NewAddressPolicy([]netip.Prefix{netip.MustParsePrefix("127.0.0.0/8")})
+
+func TestClientSend(t *testing.T) {
+	body := []byte(`{"id":1}`)
+
+	var received http.Header
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(time.Second, loopback)
+	client.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	err := client.Send(context.Background(), Request{URL: server.URL, Secret: "secret", ID: "1", Event: "link.created", Body: body})
+	require.NoError(t, err)
+	require.Equal(t, body, receivedBody)
+	require.Equal(t, "1", received.Get(IDHeader))
+	require.Equal(t, "link.created", received.Get(EventHeader))
+	require.Equal(t, "1700000000", received.Get(TimestampHeader))
+	require.Equal(t, Sign("secret", "1700000000", body), received.Get(SignatureHeader))
+	require.NotEqual(t, Sign("other", "1700000000", body), received.Get(SignatureHeader))
+}
+
+func TestClientSendNonSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	}))
+	defer server.Close()
+
+	err := NewClient(time.Second, loopback).Send(context.Background(), Request{URL: server.URL})
+	require.Error(t, err)
+}
+
+func TestClientSendRefusesAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := NewClient(time.Second, NewAddressPolicy(nil)).Send(context.Background(), Request{URL: server.URL})
+	require.ErrorIs(t, err, ErrAddressNotAllowed)
+	require.False(t, called)
+}
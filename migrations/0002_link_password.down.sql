@@ -0,0 +1,13 @@
+DO $$
+BEGIN
+	IF EXISTS (SELECT 1 FROM "links" GROUP BY "href" HAVING count(*) > 1) THEN
+		RAISE EXCEPTION 'links share an href, so links_href_key cannot be restored; merge or delete the duplicates first';
+	END IF;
+END;
+$$;
+
+DROP INDEX IF EXISTS "links_reusable_href_key";
+ALTER TABLE "links" ADD CONSTRAINT "links_href_key" UNIQUE ("href");
+
+ALTER TABLE "links" DROP COLUMN IF EXISTS "reusable";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "password_hash";
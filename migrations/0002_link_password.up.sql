@@ -0,0 +1,5 @@
+ALTER TABLE "links" ADD COLUMN "password_hash" text;
+ALTER TABLE "links" ADD COLUMN "reusable" boolean NOT NULL DEFAULT true;
+
+ALTER TABLE "links" DROP CONSTRAINT "links_href_key";
+CREATE UNIQUE INDEX "links_reusable_href_key" ON "links" ("href") WHERE "reusable";
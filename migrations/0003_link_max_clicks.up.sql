@@ -0,0 +1 @@
+ALTER TABLE "links" ADD COLUMN "max_clicks" bigint CHECK ("max_clicks" > 0);
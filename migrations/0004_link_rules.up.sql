@@ -0,0 +1 @@
+ALTER TABLE "links" ADD COLUMN "rules" jsonb NOT NULL DEFAULT '[]';
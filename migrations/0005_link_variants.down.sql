@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "link_clicks";
+
+ALTER TABLE "links" DROP COLUMN IF EXISTS "sticky_variants";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "variants";
@@ -0,0 +1,11 @@
+ALTER TABLE "links" ADD COLUMN "variants" jsonb NOT NULL DEFAULT '[]';
+ALTER TABLE "links" ADD COLUMN "sticky_variants" boolean NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS "link_clicks" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"link_id" bigint NOT NULL REFERENCES "links" ("id") ON DELETE CASCADE,
+	"variant" text,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "link_clicks_link_id_idx" ON "link_clicks" ("link_id");
@@ -0,0 +1 @@
+ALTER TABLE "links" ADD COLUMN "query_options" jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS "events" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"type" text NOT NULL,
+	"link_id" bigint NOT NULL,
+	"payload" jsonb NOT NULL,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"dispatched_at" timestamp with time zone,
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "events_undispatched_idx" ON "events" ("id") WHERE "dispatched_at" IS NULL;
+
+CREATE TABLE IF NOT EXISTS "webhooks" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"url" text NOT NULL,
+	"secret" text NOT NULL,
+	"event_types" text[] NOT NULL DEFAULT '{}',
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"webhook_id" bigint NOT NULL REFERENCES "webhooks" ("id") ON DELETE CASCADE,
+	"event_id" bigint NOT NULL REFERENCES "events" ("id") ON DELETE CASCADE,
+	"status" text NOT NULL DEFAULT 'pending',
+	"attempts" integer NOT NULL DEFAULT 0,
+	"next_attempt_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"last_error" text,
+	"delivered_at" timestamp with time zone,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id"),
+	UNIQUE ("webhook_id", "event_id")
+);
+CREATE INDEX "webhook_deliveries_pending_idx" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "events_unpublished_idx";
+ALTER TABLE "events" DROP COLUMN IF EXISTS "published_at";
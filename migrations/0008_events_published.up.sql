@@ -0,0 +1,2 @@
+ALTER TABLE "events" ADD COLUMN "published_at" timestamp with time zone;
+CREATE INDEX "events_unpublished_idx" ON "events" ("id") WHERE "published_at" IS NULL;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "short_id_tombstones";
+
+DELETE FROM "links" WHERE "deleted_at" IS NOT NULL;
+DROP INDEX IF EXISTS "links_deleted_at_idx";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "deleted_at";
@@ -0,0 +1,9 @@
+ALTER TABLE "links" ADD COLUMN "deleted_at" timestamp with time zone;
+CREATE INDEX "links_deleted_at_idx" ON "links" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS "short_id_tombstones" (
+	"short_id" text NOT NULL,
+	"deleted_at" timestamp with time zone NOT NULL,
+	"purged_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("short_id")
+);
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "audit_log" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"link_id" bigint NOT NULL,
+	"short_id" text NOT NULL,
+	"action" text NOT NULL,
+	"actor_type" text NOT NULL,
+	"actor_id" text NOT NULL,
+	"old_value" jsonb,
+	"new_value" jsonb,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "audit_log_short_id_idx" ON "audit_log" ("short_id", "id");
+CREATE INDEX "audit_log_actor_idx" ON "audit_log" ("actor_type", "actor_id", "id");
+CREATE INDEX "audit_log_created_at_idx" ON "audit_log" ("created_at");
+
+CREATE OR REPLACE FUNCTION "audit_log_append_only"() RETURNS trigger AS $$
+BEGIN
+	RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "audit_log_append_only"
+BEFORE UPDATE OR DELETE OR TRUNCATE ON "audit_log"
+FOR EACH STATEMENT EXECUTE FUNCTION "audit_log_append_only"();
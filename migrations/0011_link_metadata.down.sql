@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS "links_metadata_idx";
+DROP INDEX IF EXISTS "links_tags_idx";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "metadata";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "tags";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "description";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "title";
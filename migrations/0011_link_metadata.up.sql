@@ -0,0 +1,6 @@
+ALTER TABLE "links" ADD COLUMN "title" text NOT NULL DEFAULT '';
+ALTER TABLE "links" ADD COLUMN "description" text NOT NULL DEFAULT '';
+ALTER TABLE "links" ADD COLUMN "tags" text[] NOT NULL DEFAULT '{}';
+ALTER TABLE "links" ADD COLUMN "metadata" jsonb NOT NULL DEFAULT '{}';
+CREATE INDEX "links_tags_idx" ON "links" USING GIN ("tags");
+CREATE INDEX "links_metadata_idx" ON "links" USING GIN ("metadata" jsonb_path_ops);
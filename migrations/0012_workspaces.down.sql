@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS "audit_log_workspace_id_idx";
+ALTER TABLE "audit_log" DROP COLUMN IF EXISTS "workspace_id";
+ALTER TABLE "short_id_tombstones" DROP COLUMN IF EXISTS "workspace_id";
+ALTER TABLE "events" DROP COLUMN IF EXISTS "workspace_id";
+ALTER TABLE "link_clicks" DROP COLUMN IF EXISTS "workspace_id";
+
+DROP INDEX IF EXISTS "webhooks_workspace_id_idx";
+ALTER TABLE "webhooks" DROP COLUMN IF EXISTS "workspace_id";
+
+DROP INDEX IF EXISTS "links_workspace_id_idx";
+DROP INDEX IF EXISTS "links_reusable_href_key";
+ALTER TABLE "links" DROP COLUMN IF EXISTS "workspace_id";
+CREATE UNIQUE INDEX "links_reusable_href_key" ON "links" ("href") WHERE "reusable";
+
+DROP TABLE IF EXISTS "workspace_domains";
+DROP TABLE IF EXISTS "api_keys";
+DROP TABLE IF EXISTS "workspace_members";
+DROP TABLE IF EXISTS "workspaces";
@@ -0,0 +1,63 @@
+CREATE TABLE IF NOT EXISTS "workspaces" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"slug" text NOT NULL UNIQUE,
+	"name" text NOT NULL,
+	"max_links" bigint CHECK ("max_links" > 0),
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+-- The default workspace gets id 1 and owns everything created before
+-- workspaces existed.
+INSERT INTO "workspaces" ("slug", "name") VALUES ('default', 'Default');
+
+CREATE TABLE IF NOT EXISTS "workspace_members" (
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id") ON DELETE CASCADE,
+	"user_id" text NOT NULL,
+	"role" text NOT NULL CHECK ("role" IN ('viewer', 'editor', 'admin')),
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("workspace_id", "user_id")
+);
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id") ON DELETE CASCADE,
+	"name" text NOT NULL,
+	"role" text NOT NULL CHECK ("role" IN ('viewer', 'editor', 'admin')),
+	"key_hash" text NOT NULL UNIQUE,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "api_keys_workspace_id_idx" ON "api_keys" ("workspace_id", "id");
+
+CREATE TABLE IF NOT EXISTS "workspace_domains" (
+	"domain" text NOT NULL,
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id") ON DELETE CASCADE,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("domain")
+);
+CREATE INDEX "workspace_domains_workspace_id_idx" ON "workspace_domains" ("workspace_id");
+
+ALTER TABLE "links" ADD COLUMN "workspace_id" bigint NOT NULL DEFAULT 1 REFERENCES "workspaces" ("id");
+ALTER TABLE "links" ALTER COLUMN "workspace_id" DROP DEFAULT;
+DROP INDEX IF EXISTS "links_reusable_href_key";
+CREATE UNIQUE INDEX "links_reusable_href_key" ON "links" ("workspace_id", "href") WHERE "reusable";
+CREATE INDEX "links_workspace_id_idx" ON "links" ("workspace_id", "id");
+
+ALTER TABLE "webhooks" ADD COLUMN "workspace_id" bigint NOT NULL DEFAULT 1 REFERENCES "workspaces" ("id");
+ALTER TABLE "webhooks" ALTER COLUMN "workspace_id" DROP DEFAULT;
+CREATE INDEX "webhooks_workspace_id_idx" ON "webhooks" ("workspace_id", "id");
+
+-- Rows outliving their link keep the workspace without a foreign key, like
+-- the audit log keeps the link.
+ALTER TABLE "link_clicks" ADD COLUMN "workspace_id" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "link_clicks" ALTER COLUMN "workspace_id" DROP DEFAULT;
+
+ALTER TABLE "events" ADD COLUMN "workspace_id" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "events" ALTER COLUMN "workspace_id" DROP DEFAULT;
+
+ALTER TABLE "short_id_tombstones" ADD COLUMN "workspace_id" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "short_id_tombstones" ALTER COLUMN "workspace_id" DROP DEFAULT;
+
+ALTER TABLE "audit_log" ADD COLUMN "workspace_id" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "audit_log" ALTER COLUMN "workspace_id" DROP DEFAULT;
+CREATE INDEX "audit_log_workspace_id_idx" ON "audit_log" ("workspace_id", "id");
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/sethvargo/go-envconfig"
@@ -12,27 +15,136 @@ import (
 
 var validate = validator.New(validator.WithRequiredStructEnabled())
 
-func GetConfig[T any](path string) (T, error) {
+// GetConfig reads the YAML files in order, each one overriding the values of
+// the previous ones, then applies environment variables and defaults and
+// validates.
+//
+// Files may reference environment variables as ${VAR} or ${VAR:-default}.
+// An environment variable NAME can also be provided as NAME_FILE holding a
+// path to a file with the value, which is how container secrets are mounted.
+// Environment variables only fill values the files leave empty, and defaults
+// only fill values set by neither.
+func GetConfig[T any](paths ...string) (T, error) {
+	config, _, err := Load[T](paths...)
+	return config, err
+}
+
+// Sources maps a Field.Path to where its value came from: "file <path>",
+// "env <NAME>", "default", or "" when the value was left unset.
+type Sources map[string]string
+
+// Load is GetConfig that also reports the source of every value.
+func Load[T any](paths ...string) (T, Sources, error) {
 	var zero, config T
+	fs := Fields[T]()
+	sources := make(Sources, len(fs))
+	inFiles := make(map[string]bool)
 
-	b, err := os.ReadFile(path)
-	if err != nil {
-		return zero, fmt.Errorf("os.ReadFile: %w", err)
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return zero, nil, fmt.Errorf("os.ReadFile: %w", err)
+		}
+
+		b, err = interpolate(b)
+		if err != nil {
+			return zero, nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		err = yaml.Unmarshal(b, &config)
+		if err != nil {
+			return zero, nil, fmt.Errorf("yaml.Unmarshal: %w", err)
+		}
+
+		set, err := setPaths(b, fs)
+		if err != nil {
+			return zero, nil, fmt.Errorf("yaml.Unmarshal: %w", err)
+		}
+		for p := range set {
+			inFiles[p] = true
+			sources[p] = "file " + path
+		}
 	}
 
-	err = yaml.Unmarshal(b, &config)
+	secrets, err := fileSecrets(os.Environ())
 	if err != nil {
-		return zero, fmt.Errorf("yaml.Unmarshal: %w", err)
+		return zero, nil, err
+	}
+	fromFiles := config
+	err = envconfig.ProcessWith(context.Background(), &envconfig.Config{
+		Target:   &config,
+		Lookuper: envconfig.MultiLookuper(envconfig.OsLookuper(), envconfig.MapLookuper(secrets)),
+	})
+	if err != nil {
+		return zero, nil, fmt.Errorf("envconfig.Process: %w", err)
 	}
 
-	if err := envconfig.Process(context.Background(), &config); err != nil {
-		return zero, fmt.Errorf("envconfig.Process: %w", err)
+	v := reflect.ValueOf(&config).Elem()
+	before := reflect.ValueOf(&fromFiles).Elem()
+	for _, f := range fs {
+		if reflect.DeepEqual(v.FieldByIndex(f.index).Interface(), before.FieldByIndex(f.index).Interface()) {
+			continue
+		}
+		if _, ok := os.LookupEnv(f.Env); !ok {
+			sources[f.Path] = "env " + f.Env + "_FILE"
+			continue
+		}
+		sources[f.Path] = "env " + f.Env
+	}
+
+	err = applyDefaults(v, fs, inFiles)
+	if err != nil {
+		return zero, nil, err
+	}
+	for _, f := range fs {
+		if sources[f.Path] == "" && f.Default != "" {
+			sources[f.Path] = "default"
+		}
 	}
 
 	err = validate.Struct(&config)
 	if err != nil {
-		return zero, fmt.Errorf("validate.StructCtx: %w", err)
+		return zero, nil, fmt.Errorf("validate.StructCtx: %w", err)
 	}
 
-	return config, nil
+	return config, sources, nil
+}
+
+var placeholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+func interpolate(b []byte) ([]byte, error) {
+	var err error
+	out := placeholder.ReplaceAllFunc(b, func(m []byte) []byte {
+		groups := placeholder.FindSubmatch(m)
+		if v, ok := os.LookupEnv(string(groups[1])); ok {
+			return []byte(v)
+		}
+		if groups[2] != nil {
+			return groups[3]
+		}
+		if err == nil {
+			err = fmt.Errorf("environment variable %s is not set", groups[1])
+		}
+		return m
+	})
+	return out, err
+}
+
+// fileSecrets reads the files named by NAME_FILE variables and returns their
+// contents keyed by NAME.
+func fileSecrets(environ []string) (map[string]string, error) {
+	secrets := make(map[string]string)
+	for _, kv := range environ {
+		key, path, _ := strings.Cut(kv, "=")
+		name, ok := strings.CutSuffix(key, "_FILE")
+		if !ok || name == "" {
+			continue
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+		secrets[name] = strings.TrimRight(string(b), "\r\n")
+	}
+	return secrets, nil
 }
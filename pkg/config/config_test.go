@@ -1,6 +1,10 @@
 package config
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -76,3 +80,145 @@ func TestGetConfig(t *testing.T) {
 		r.Equal(exp, cfg)
 	})
 }
+
+type layeredConfig struct {
+	Server struct {
+		Host        string        `yaml:"host" validate:"required"`
+		Port        uint          `yaml:"port" validate:"required"`
+		ReadTimeout time.Duration `yaml:"read_timeout"`
+	} `yaml:"server"`
+	DB struct {
+		Password string `env:"DB_PASSWORD" yaml:"password" validate:"required"`
+	} `yaml:"db"`
+	ShortID struct {
+		Len      int    `yaml:"len" validate:"min=8"`
+		Alphabet string `yaml:"alphabet" validate:"required"`
+	} `yaml:"short_id"`
+}
+
+func TestGetConfigLayers(t *testing.T) {
+	r := require.New(t)
+
+	secret := filepath.Join(t.TempDir(), "db_password")
+	r.NoError(os.WriteFile(secret, []byte("s3cret\n"), 0o600))
+	t.Setenv("DB_PASSWORD_FILE", secret)
+	t.Setenv("SHORT_ID_TEST_ALPHABET", "abc")
+
+	cfg, err := GetConfig[layeredConfig]("./testdata/test_config.yaml", "./testdata/overlay_config.yaml")
+	r.NoError(err)
+
+	r.Equal("localhost", cfg.Server.Host)
+	r.Equal(uint(9000), cfg.Server.Port)
+	r.Equal(5*time.Second, cfg.Server.ReadTimeout)
+	r.Equal(11, cfg.ShortID.Len)
+	r.Equal("abc", cfg.ShortID.Alphabet)
+	r.Equal("s3cret", cfg.DB.Password)
+
+	t.Setenv("DB_PASSWORD", "from-env")
+	cfg, err = GetConfig[layeredConfig]("./testdata/test_config.yaml", "./testdata/overlay_config.yaml")
+	r.NoError(err)
+	r.Equal("from-env", cfg.DB.Password)
+}
+
+func TestGetConfigUnsetVariable(t *testing.T) {
+	_, err := GetConfig[layeredConfig]("./testdata/test_config.yaml", "./testdata/overlay_config.yaml")
+	require.ErrorContains(t, err, "SHORT_ID_TEST_ALPHABET is not set")
+}
+
+func TestWatcher(t *testing.T) {
+	r := require.New(t)
+	t.Setenv("DB_PASSWORD", "dbpassword")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(len int) {
+		r.NoError(os.WriteFile(path, []byte(fmt.Sprintf("server: {host: localhost, port: 8000}\nshort_id: {len: %d, alphabet: abc}\n", len)), 0o600))
+	}
+	write(11)
+
+	w, err := NewWatcher[layeredConfig](path)
+	r.NoError(err)
+
+	var got []int
+	w.Subscribe(func(cfg layeredConfig) { got = append(got, cfg.ShortID.Len) })
+
+	write(12)
+	r.NoError(w.Reload())
+	r.Equal(12, w.Config().ShortID.Len)
+
+	write(4)
+	r.Error(w.Reload())
+	r.Equal(12, w.Config().ShortID.Len)
+	r.Equal([]int{12}, got)
+}
+
+type describedConfig struct {
+	Server struct {
+		Host        string        `env:"HOST" yaml:"host" validate:"required"`
+		ReadTimeout time.Duration `env:"READ_TIMEOUT" yaml:"read_timeout" default:"0s" desc:"0 disables the timeout"`
+		IdleTimeout time.Duration `env:"IDLE_TIMEOUT" yaml:"idle_timeout" default:"30s"`
+	} `env:", prefix=SERVER_" yaml:"server"`
+	DB struct {
+		Password string `env:"PASSWORD" yaml:"password" secret:"true"`
+		Port     uint   `env:"PORT" yaml:"port" default:"5432"`
+	} `env:", prefix=DB_" yaml:"db"`
+	ShortID struct {
+		Alphabet   string  `env:"ALPHABET" yaml:"alphabet" validate:"required"`
+		Thresholds []int64 `env:"THRESHOLDS" yaml:"thresholds" default:"[1, 10]"`
+	} `env:", prefix=SHORT_ID_" yaml:"short_id"`
+}
+
+func TestLoadSources(t *testing.T) {
+	r := require.New(t)
+	t.Setenv("SERVER_IDLE_TIMEOUT", "5s")
+	t.Setenv("DB_PASSWORD", "s3cret")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	r.NoError(os.WriteFile(path, []byte("server: {host: localhost, read_timeout: 1s}\nshort_id: {alphabet: abc}\n"), 0o600))
+
+	cfg, sources, err := Load[describedConfig](path)
+	r.NoError(err)
+
+	r.Equal(time.Second, cfg.Server.ReadTimeout)
+	r.Equal(5*time.Second, cfg.Server.IdleTimeout)
+	r.Equal(uint(5432), cfg.DB.Port)
+	r.Equal([]int64{1, 10}, cfg.ShortID.Thresholds)
+	r.Equal(Sources{
+		"server.host":         "file " + path,
+		"server.read_timeout": "file " + path,
+		"server.idle_timeout": "env SERVER_IDLE_TIMEOUT",
+		"db.password":         "env DB_PASSWORD",
+		"db.port":             "default",
+		"short_id.alphabet":   "file " + path,
+		"short_id.thresholds": "default",
+	}, sources)
+
+	var out bytes.Buffer
+	r.NoError(Print(&out, cfg, sources))
+	r.Contains(out.String(), "db.password          <redacted>")
+	r.NotContains(out.String(), "s3cret")
+	r.Contains(out.String(), "short_id.thresholds  [1, 10]     # default")
+}
+
+func TestDefaultsKeepExplicitZero(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("server: {host: localhost, idle_timeout: 0s}\nshort_id: {alphabet: abc}\n"), 0o600))
+
+	cfg, err := GetConfig[describedConfig](path)
+	require.NoError(t, err)
+	require.Zero(t, cfg.Server.IdleTimeout)
+}
+
+func TestWriteReference(t *testing.T) {
+	r := require.New(t)
+
+	var md bytes.Buffer
+	r.NoError(WriteMarkdown[describedConfig](&md, "Configuration", "make docs"))
+	r.Contains(md.String(), "## server\n")
+	r.Contains(md.String(), "| `server.read_timeout` | `SERVER_READ_TIMEOUT` | duration | `0s` |  | 0 disables the timeout |\n")
+	r.Contains(md.String(), "| `short_id.alphabet` | `SHORT_ID_ALPHABET` | string |  | `required` |  |\n")
+
+	var env bytes.Buffer
+	r.NoError(WriteEnv[describedConfig](&env, "make env"))
+	r.Contains(env.String(), "# 0 disables the timeout\n# server.read_timeout (duration)\n#SERVER_READ_TIMEOUT=0s\n")
+	r.Contains(env.String(), "#SHORT_ID_THRESHOLDS=1,10\n")
+}
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown writes a reference of the fields of T as Markdown tables, one
+// per top-level section.
+func WriteMarkdown[T any](w io.Writer, title, generatedBy string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "<!-- Generated by `%s`. DO NOT EDIT. -->\n\n", generatedBy)
+	b.WriteString("Values are read from the YAML files passed with `-config`, later files overriding earlier ones. " +
+		"Environment variables fill the values the files leave empty, and defaults fill the rest. " +
+		"Any variable can also be given as `NAME_FILE` pointing to a file with the value.\n")
+
+	section := ""
+	for _, f := range Fields[T]() {
+		if s, _, _ := strings.Cut(f.Path, "."); s != section {
+			section = s
+			fmt.Fprintf(&b, "\n## %s\n\n", section)
+			b.WriteString("| Key | Env | Type | Default | Validation | Description |\n")
+			b.WriteString("|-----|-----|------|---------|------------|-------------|\n")
+		}
+		fmt.Fprintf(&b, "| `%s` | %s | %s | %s | %s | %s |\n",
+			f.Path, code(f.Env), f.Type, code(f.Default), code(f.Validate), cell(f.Desc))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteEnv writes an env file listing every variable of T, commented out and
+// set to its default.
+func WriteEnv[T any](w io.Writer, generatedBy string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Generated by `%s`. DO NOT EDIT.\n", generatedBy)
+	for _, f := range Fields[T]() {
+		if f.Env == "" {
+			continue
+		}
+		b.WriteString("\n")
+		if f.Desc != "" {
+			fmt.Fprintf(&b, "# %s\n", f.Desc)
+		}
+		fmt.Fprintf(&b, "# %s (%s", f.Path, f.Type)
+		if f.Validate != "" {
+			fmt.Fprintf(&b, ", %s", f.Validate)
+		}
+		b.WriteString(")\n")
+		fmt.Fprintf(&b, "#%s=%s\n", f.Env, envValue(f.Default))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// envValue converts a YAML flow list default to envconfig's comma-separated
+// form.
+func envValue(s string) string {
+	list, ok := strings.CutPrefix(s, "[")
+	if !ok {
+		return s
+	}
+	items := strings.Split(strings.TrimSuffix(list, "]"), ",")
+	for i, item := range items {
+		items[i] = strings.TrimSpace(item)
+	}
+	return strings.Join(items, ",")
+}
+
+func code(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + cell(s) + "`"
+}
+
+func cell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Field describes a configuration value as declared by the struct tags:
+//
+//	yaml:"name"           key within the parent section
+//	env:"NAME"            environment variable, prefixed by the parent's prefix=
+//	validate:"..."        validation rules
+//	default:"..."         YAML value used when neither a file nor the env sets it
+//	secret:"true"         redacted by Print
+//	desc:"..."            description for the generated reference
+type Field struct {
+	Path     string
+	Env      string
+	Type     string
+	Default  string
+	Validate string
+	Desc     string
+	Secret   bool
+
+	index []int
+}
+
+// Fields lists the leaf values of T in declaration order.
+func Fields[T any]() []Field {
+	var zero T
+	return fields(reflect.TypeOf(zero), nil, "", "")
+}
+
+func fields(t reflect.Type, index []int, path, envPrefix string) []Field {
+	var out []Field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(sf.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(sf.Name)
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+		idx := append(append([]int(nil), index...), i)
+		env, prefix := parseEnvTag(sf.Tag.Get("env"))
+
+		if sf.Type.Kind() == reflect.Struct && sf.Type != reflect.TypeOf(time.Time{}) {
+			out = append(out, fields(sf.Type, idx, name, envPrefix+prefix)...)
+			continue
+		}
+
+		if env != "" {
+			env = envPrefix + env
+		}
+		out = append(out, Field{
+			Path:     name,
+			Env:      env,
+			Type:     typeName(sf.Type),
+			Default:  sf.Tag.Get("default"),
+			Validate: sf.Tag.Get("validate"),
+			Desc:     sf.Tag.Get("desc"),
+			Secret:   sf.Tag.Get("secret") == "true",
+			index:    idx,
+		})
+	}
+	return out
+}
+
+func parseEnvTag(tag string) (name, prefix string) {
+	parts := strings.Split(tag, ",")
+	name = strings.TrimSpace(parts[0])
+	for _, opt := range parts[1:] {
+		if p, ok := strings.CutPrefix(strings.TrimSpace(opt), "prefix="); ok {
+			prefix = p
+		}
+	}
+	return name, prefix
+}
+
+func typeName(t reflect.Type) string {
+	switch {
+	case t == reflect.TypeOf(time.Duration(0)):
+		return "duration"
+	case t.Kind() == reflect.Slice:
+		return "list of " + typeName(t.Elem())
+	case t.Kind() == reflect.Bool:
+		return "bool"
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		return "integer"
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return "number"
+	default:
+		return t.Kind().String()
+	}
+}
+
+// applyDefaults sets the default of every zero field that no file set.
+func applyDefaults(v reflect.Value, fs []Field, inFiles map[string]bool) error {
+	for _, f := range fs {
+		if f.Default == "" || inFiles[f.Path] {
+			continue
+		}
+		fv := v.FieldByIndex(f.index)
+		if !fv.IsZero() {
+			continue
+		}
+		if err := yaml.Unmarshal([]byte(f.Default), fv.Addr().Interface()); err != nil {
+			return fmt.Errorf("default of %s: %w", f.Path, err)
+		}
+	}
+	return nil
+}
+
+// setPaths reports which fields are present in a YAML document.
+func setPaths(b []byte, fs []Field) (map[string]bool, error) {
+	var doc map[string]any
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool)
+	for _, f := range fs {
+		m := doc
+		keys := strings.Split(f.Path, ".")
+		for i, key := range keys {
+			v, ok := m[key]
+			if !ok {
+				break
+			}
+			if i == len(keys)-1 {
+				set[f.Path] = true
+				break
+			}
+			if m, ok = v.(map[string]any); !ok {
+				break
+			}
+		}
+	}
+	return set, nil
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const redacted = "<redacted>"
+
+// Print writes every value of config with its source, redacting secrets.
+func Print[T any](w io.Writer, config T, sources Sources) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	v := reflect.ValueOf(config)
+	for _, f := range Fields[T]() {
+		value := formatValue(v.FieldByIndex(f.index))
+		if f.Secret && !v.FieldByIndex(f.index).IsZero() {
+			value = redacted
+		}
+		source := sources[f.Path]
+		if source == "" {
+			source = "unset"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t# %s\n", f.Path, value, source)
+	}
+	return tw.Flush()
+}
+
+func formatValue(v reflect.Value) string {
+	switch {
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		return time.Duration(v.Int()).String()
+	case v.Kind() == reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = formatValue(v.Index(i))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case v.Kind() == reflect.String && v.Len() == 0:
+		return `""`
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Watcher holds the current configuration and replaces it on Reload. A
+// configuration that fails to load or validate is rejected and the previous
+// one stays in effect.
+type Watcher[T any] struct {
+	paths    []string
+	current  atomic.Pointer[T]
+	mu       sync.Mutex
+	subs     []func(T)
+	modTimes map[string]time.Time
+}
+
+func NewWatcher[T any](paths ...string) (*Watcher[T], error) {
+	w := &Watcher[T]{paths: paths}
+	if err := w.Reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *Watcher[T]) Config() T {
+	return *w.current.Load()
+}
+
+// Subscribe registers fn to be called with every configuration accepted by a
+// later Reload.
+func (w *Watcher[T]) Subscribe(fn func(T)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subs = append(w.subs, fn)
+}
+
+func (w *Watcher[T]) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	modTimes, err := statFiles(w.paths)
+	if err != nil {
+		return err
+	}
+	// Remember the files even if they are rejected, so that a broken edit is
+	// reported once rather than on every poll.
+	w.modTimes = modTimes
+
+	cfg, err := GetConfig[T](w.paths...)
+	if err != nil {
+		return err
+	}
+
+	initial := w.current.Load() == nil
+	w.current.Store(&cfg)
+	if !initial {
+		for _, fn := range w.subs {
+			fn(cfg)
+		}
+	}
+	return nil
+}
+
+// Watch reloads the configuration on SIGHUP and when a file changes, polling
+// modification times every interval, until ctx is cancelled.
+func (w *Watcher[T]) Watch(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		case <-ticker.C:
+			if !w.changed() {
+				continue
+			}
+		}
+		if err := w.Reload(); err != nil {
+			log.Printf("config reload rejected, keeping the previous configuration: %v", err)
+			continue
+		}
+		log.Println("Configuration reloaded")
+	}
+}
+
+func (w *Watcher[T]) changed() bool {
+	modTimes, err := statFiles(w.paths)
+	if err != nil {
+		return false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for path, modTime := range modTimes {
+		if !modTime.Equal(w.modTimes[path]) {
+			return true
+		}
+	}
+	return false
+}
+
+func statFiles(paths []string) (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("os.Stat: %w", err)
+		}
+		modTimes[path] = info.ModTime()
+	}
+	return modTimes, nil
+}
@@ -0,0 +1,20 @@
+-- name: CreateAuditEntry :exec
+INSERT INTO "audit_log" ("link_id", "short_id", "action", "actor_type", "actor_id", "old_value", "new_value", "workspace_id") 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: CreateAuditEntries :batchexec
+INSERT INTO "audit_log" ("link_id", "short_id", "action", "actor_type", "actor_id", "old_value", "new_value", "workspace_id") 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: ListAuditEntries :many
+SELECT * FROM "audit_log" 
+WHERE "workspace_id" = @workspace_id 
+	AND (sqlc.narg('short_id')::text IS NULL OR "short_id" = sqlc.narg('short_id')) 
+	AND (sqlc.narg('action')::text IS NULL OR "action" = sqlc.narg('action')) 
+	AND (sqlc.narg('actor_type')::text IS NULL OR "actor_type" = sqlc.narg('actor_type')) 
+	AND (sqlc.narg('actor_id')::text IS NULL OR "actor_id" = sqlc.narg('actor_id')) 
+	AND (sqlc.narg('since')::timestamptz IS NULL OR "created_at" >= sqlc.narg('since')) 
+	AND (sqlc.narg('until')::timestamptz IS NULL OR "created_at" < sqlc.narg('until')) 
+	AND (sqlc.narg('before_id')::bigint IS NULL OR "id" < sqlc.narg('before_id')) 
+ORDER BY "id" DESC 
+LIMIT @max_results;
@@ -0,0 +1,33 @@
+-- name: CreateEvent :exec
+INSERT INTO "events" ("type", "link_id", "payload", "workspace_id") 
+VALUES ($1, $2, $3, $4);
+
+-- name: CreateEvents :batchexec
+INSERT INTO "events" ("type", "link_id", "payload", "workspace_id") 
+VALUES ($1, $2, $3, $4);
+
+-- name: ListUndispatchedEvents :many
+SELECT * FROM "events" 
+WHERE "dispatched_at" IS NULL 
+ORDER BY "id" 
+LIMIT $1 
+FOR UPDATE SKIP LOCKED;
+
+-- name: ListUnpublishedEvents :many
+SELECT * FROM "events" 
+WHERE "published_at" IS NULL 
+ORDER BY "id" 
+LIMIT $1;
+
+-- name: LockOutbox :one
+SELECT pg_try_advisory_xact_lock($1);
+
+-- name: MarkEventDispatched :batchexec
+UPDATE "events" 
+SET "dispatched_at" = NOW() 
+WHERE "id" = $1;
+
+-- name: MarkEventsPublished :exec
+UPDATE "events" 
+SET "published_at" = NOW() 
+WHERE "id" = ANY(@ids::bigint[]);
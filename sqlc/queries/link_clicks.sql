@@ -0,0 +1,9 @@
+-- name: CreateLinkClick :exec
+INSERT INTO "link_clicks" ("link_id", "variant", "workspace_id") 
+VALUES ($1, $2, $3);
+
+-- name: CountLinkClicksByVariant :many
+SELECT "variant", COUNT(*) FROM "link_clicks" 
+WHERE "link_id" = $1 
+GROUP BY "variant" 
+ORDER BY "variant";
@@ -1,18 +1,99 @@
--- name: GetLinkByHref :one
-SELECT * FROM "links" WHERE "href" = $1;
+-- name: GetReusableLinkByHref :one
+SELECT * FROM "links" WHERE "workspace_id" = $1 AND "href" = $2 AND "reusable";
 
 -- name: GetLinkByShortID :one
 SELECT * FROM "links" WHERE "short_id" = $1;
 
--- name: IsLinkExistByShortID :one
-SELECT EXISTS(SELECT 1 FROM "links" WHERE "short_id" = $1);
+-- name: GetWorkspaceLinkByShortID :one
+SELECT * FROM "links" WHERE "workspace_id" = $1 AND "short_id" = $2;
+
+-- name: GetLinkByShortIDForUpdate :one
+SELECT * FROM "links" WHERE "workspace_id" = $1 AND "short_id" = $2 FOR UPDATE;
+
+-- name: IsShortIDTaken :one
+SELECT EXISTS(SELECT 1 FROM "links" WHERE "short_id" = $1)
+	OR EXISTS(SELECT 1 FROM "short_id_tombstones" WHERE "short_id" = $1);
+
+-- name: IsShortIDInWorkspace :one
+SELECT EXISTS(SELECT 1 FROM "links" WHERE "workspace_id" = $1 AND "short_id" = $2)
+	OR EXISTS(SELECT 1 FROM "short_id_tombstones" WHERE "workspace_id" = $1 AND "short_id" = $2);
+
+-- name: ListTakenShortIDs :many
+SELECT "short_id" FROM "links" WHERE "short_id" = ANY(@short_ids::text[])
+UNION ALL
+SELECT "short_id" FROM "short_id_tombstones" WHERE "short_id" = ANY(@short_ids::text[]);
+
+-- name: CountShortIDsByLength :one
+SELECT ((SELECT COUNT(*) FROM "links" WHERE length("short_id") = @length::int)
+	+ (SELECT COUNT(*) FROM "short_id_tombstones" WHERE length("short_id") = @length::int))::bigint AS "count";
+
+-- name: ListLinksByShortIDs :many
+SELECT * FROM "links" WHERE "short_id" = ANY(@short_ids::text[]);
+
+-- name: ImportLinks :copyfrom
+INSERT INTO "links" ("workspace_id", "short_id", "href") VALUES ($1, $2, $3);
+
+-- name: ListLinks :many
+SELECT * FROM "links" 
+WHERE "workspace_id" = @workspace_id AND "deleted_at" IS NULL AND "tags" @> @tags::text[] AND "metadata" @> @metadata::jsonb 
+	AND (sqlc.narg('before_id')::bigint IS NULL OR "id" < sqlc.narg('before_id')) 
+ORDER BY "id" DESC 
+LIMIT @max_results;
+
+-- name: CountWorkspaceLinks :one
+SELECT COUNT(*) FROM "links" WHERE "workspace_id" = $1 AND "deleted_at" IS NULL;
+
+-- name: ListTags :many
+SELECT "tag"::text AS "tag", COUNT(*) AS "links" 
+FROM "links", unnest("tags") AS "tag" 
+WHERE "workspace_id" = $1 AND "deleted_at" IS NULL 
+GROUP BY "tag" 
+ORDER BY "links" DESC, "tag";
 
 -- name: CreateLink :one
-INSERT INTO "links" ("short_id", "href") 
-VALUES ($1, $2) 
+INSERT INTO "links" ("short_id", "href", "password_hash", "reusable", "max_clicks", "rules", "variants", "sticky_variants", "query_options", "title", "description", "tags", "metadata", "workspace_id") 
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) 
 RETURNING *;
 
--- name: UpdateLinkUsageInfo :exec
+-- name: UpdateLinkUsageInfo :one
 UPDATE "links" 
 SET "usage_count" = "usage_count" + 1, "usage_at" = NOW()
-WHERE "id" = $1;
\ No newline at end of file
+WHERE "id" = $1 AND ("max_clicks" IS NULL OR "usage_count" < "max_clicks")
+RETURNING "usage_count", "deleted_at" IS NOT NULL AS "deleted";
+
+-- name: UpdateLink :one
+UPDATE "links" 
+SET "href" = $2, "password_hash" = $3, "reusable" = false, "max_clicks" = $4, "rules" = $5, "variants" = $6, "sticky_variants" = $7, "query_options" = $8, 
+	"title" = $9, "description" = $10, "tags" = $11, "metadata" = $12
+WHERE "id" = $1
+RETURNING *;
+
+-- name: SoftDeleteLink :one
+UPDATE "links"
+SET "deleted_at" = NOW(), "reusable" = false
+WHERE "id" = $1
+RETURNING *;
+
+-- name: RestoreLink :one
+UPDATE "links"
+SET "deleted_at" = NULL
+WHERE "id" = $1
+RETURNING *;
+
+-- name: PurgeDeletedLinks :execrows
+WITH "purged" AS (
+	DELETE FROM "links"
+	WHERE "id" IN (
+		SELECT "id" FROM "links"
+		WHERE "deleted_at" < @before::timestamptz
+		ORDER BY "deleted_at"
+		LIMIT @batch_size::int
+	)
+	RETURNING "id", "short_id", "deleted_at", "workspace_id"
+), "tombstoned" AS (
+	INSERT INTO "short_id_tombstones" ("short_id", "deleted_at", "workspace_id")
+	SELECT "short_id", "deleted_at", "workspace_id" FROM "purged"
+	ON CONFLICT DO NOTHING
+)
+INSERT INTO "audit_log" ("link_id", "short_id", "action", "actor_type", "actor_id", "workspace_id")
+SELECT "id", "short_id", @action::text, @actor_type::text, @actor_id::text, "workspace_id" FROM "purged";
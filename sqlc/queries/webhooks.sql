@@ -0,0 +1,53 @@
+-- name: CreateWebhook :one
+INSERT INTO "webhooks" ("url", "secret", "event_types", "workspace_id") 
+VALUES ($1, $2, $3, $4) 
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM "webhooks" WHERE "id" = $1 AND "workspace_id" = $2;
+
+-- name: ListWebhooks :many
+SELECT * FROM "webhooks" WHERE "workspace_id" = $1 ORDER BY "id";
+
+-- name: DeleteWebhook :execrows
+DELETE FROM "webhooks" WHERE "id" = $1 AND "workspace_id" = $2;
+
+-- name: CreateWebhookDeliveries :batchexec
+INSERT INTO "webhook_deliveries" ("webhook_id", "event_id") 
+SELECT "id", @event_id::bigint FROM "webhooks" 
+WHERE "workspace_id" = @workspace_id AND (cardinality("event_types") = 0 OR @event_type::text = ANY("event_types")) 
+ON CONFLICT DO NOTHING;
+
+-- name: ClaimWebhookDeliveries :many
+UPDATE "webhook_deliveries" AS d 
+SET "next_attempt_at" = @lease_until 
+FROM "webhooks" AS w, "events" AS e 
+WHERE d."id" IN (
+	SELECT "id" FROM "webhook_deliveries" 
+	WHERE "status" = 'pending' AND "next_attempt_at" <= NOW() 
+	ORDER BY "id" 
+	LIMIT @batch_size 
+	FOR UPDATE SKIP LOCKED
+) AND w."id" = d."webhook_id" AND e."id" = d."event_id" 
+RETURNING d."id", d."attempts", w."url", w."secret", e."id" AS "event_id", e."type", e."payload", e."created_at";
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE "webhook_deliveries" 
+SET "status" = 'delivered', "attempts" = "attempts" + 1, "delivered_at" = NOW(), "last_error" = NULL 
+WHERE "id" = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE "webhook_deliveries" 
+SET "status" = $2, "attempts" = "attempts" + 1, "next_attempt_at" = $3, "last_error" = $4 
+WHERE "id" = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM "webhook_deliveries" 
+WHERE "webhook_id" = @webhook_id AND (sqlc.narg('status')::text IS NULL OR "status" = sqlc.narg('status')) 
+ORDER BY "id" DESC 
+LIMIT @max_results;
+
+-- name: ReplayWebhookDeliveries :execrows
+UPDATE "webhook_deliveries" 
+SET "status" = 'pending', "attempts" = 0, "next_attempt_at" = NOW(), "last_error" = NULL 
+WHERE "webhook_id" = $1 AND "status" = 'dead';
@@ -0,0 +1,68 @@
+-- name: CreateWorkspace :one
+INSERT INTO "workspaces" ("slug", "name", "max_links") 
+VALUES ($1, $2, $3) 
+ON CONFLICT ("slug") DO NOTHING 
+RETURNING *;
+
+-- name: GetWorkspace :one
+SELECT * FROM "workspaces" WHERE "id" = $1;
+
+-- name: GetWorkspaceForUpdate :one
+SELECT * FROM "workspaces" WHERE "id" = $1 FOR UPDATE;
+
+-- name: GetWorkspaceBySlug :one
+SELECT * FROM "workspaces" WHERE "slug" = $1;
+
+-- name: ListWorkspaces :many
+SELECT * FROM "workspaces" ORDER BY "id";
+
+-- name: UpdateWorkspace :one
+UPDATE "workspaces" 
+SET "name" = $2, "max_links" = $3 
+WHERE "id" = $1 
+RETURNING *;
+
+-- name: UpsertWorkspaceMember :one
+INSERT INTO "workspace_members" ("workspace_id", "user_id", "role") 
+VALUES ($1, $2, $3) 
+ON CONFLICT ("workspace_id", "user_id") DO UPDATE SET "role" = EXCLUDED."role" 
+RETURNING *;
+
+-- name: GetWorkspaceMember :one
+SELECT * FROM "workspace_members" WHERE "workspace_id" = $1 AND "user_id" = $2;
+
+-- name: ListWorkspaceMembers :many
+SELECT * FROM "workspace_members" WHERE "workspace_id" = $1 ORDER BY "user_id";
+
+-- name: DeleteWorkspaceMember :execrows
+DELETE FROM "workspace_members" WHERE "workspace_id" = $1 AND "user_id" = $2;
+
+-- name: CreateAPIKey :one
+INSERT INTO "api_keys" ("workspace_id", "name", "role", "key_hash") 
+VALUES ($1, $2, $3, $4) 
+RETURNING *;
+
+-- name: GetAPIKeyByHash :one
+SELECT * FROM "api_keys" WHERE "key_hash" = $1;
+
+-- name: ListAPIKeys :many
+SELECT * FROM "api_keys" WHERE "workspace_id" = $1 ORDER BY "id";
+
+-- name: DeleteAPIKey :execrows
+DELETE FROM "api_keys" WHERE "id" = $1 AND "workspace_id" = $2;
+
+-- name: AddWorkspaceDomain :one
+INSERT INTO "workspace_domains" ("domain", "workspace_id") 
+VALUES ($1, $2) 
+ON CONFLICT ("domain") DO UPDATE SET "domain" = EXCLUDED."domain" 
+WHERE "workspace_domains"."workspace_id" = EXCLUDED."workspace_id" 
+RETURNING *;
+
+-- name: GetWorkspaceDomain :one
+SELECT * FROM "workspace_domains" WHERE "domain" = $1;
+
+-- name: ListWorkspaceDomains :many
+SELECT * FROM "workspace_domains" WHERE "workspace_id" = $1 ORDER BY "domain";
+
+-- name: DeleteWorkspaceDomain :execrows
+DELETE FROM "workspace_domains" WHERE "domain" = $1 AND "workspace_id" = $2;
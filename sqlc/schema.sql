@@ -1,9 +1,140 @@
+CREATE TABLE IF NOT EXISTS "workspaces" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"slug" text NOT NULL UNIQUE,
+	"name" text NOT NULL,
+	"max_links" bigint CHECK ("max_links" > 0),
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "workspace_members" (
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id") ON DELETE CASCADE,
+	"user_id" text NOT NULL,
+	"role" text NOT NULL CHECK ("role" IN ('viewer', 'editor', 'admin')),
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("workspace_id", "user_id")
+);
+
+CREATE TABLE IF NOT EXISTS "api_keys" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id") ON DELETE CASCADE,
+	"name" text NOT NULL,
+	"role" text NOT NULL CHECK ("role" IN ('viewer', 'editor', 'admin')),
+	"key_hash" text NOT NULL UNIQUE,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "api_keys_workspace_id_idx" ON "api_keys" ("workspace_id", "id");
+
+CREATE TABLE IF NOT EXISTS "workspace_domains" (
+	"domain" text NOT NULL,
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id") ON DELETE CASCADE,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("domain")
+);
+CREATE INDEX "workspace_domains_workspace_id_idx" ON "workspace_domains" ("workspace_id");
+
 CREATE TABLE IF NOT EXISTS "links" (
 	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
 	"short_id" text NOT NULL UNIQUE,
-	"href" text NOT NULL UNIQUE,
+	"href" text NOT NULL,
 	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
 	"usage_count" bigint NOT NULL DEFAULT 0,
 	"usage_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"password_hash" text,
+	"reusable" boolean NOT NULL DEFAULT true,
+	"max_clicks" bigint CHECK ("max_clicks" > 0),
+	"rules" jsonb NOT NULL DEFAULT '[]',
+	"variants" jsonb NOT NULL DEFAULT '[]',
+	"sticky_variants" boolean NOT NULL DEFAULT false,
+	"query_options" jsonb NOT NULL DEFAULT '{}',
+	"deleted_at" timestamp with time zone,
+	"title" text NOT NULL DEFAULT '',
+	"description" text NOT NULL DEFAULT '',
+	"tags" text[] NOT NULL DEFAULT '{}',
+	"metadata" jsonb NOT NULL DEFAULT '{}',
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id"),
+	PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "links_reusable_href_key" ON "links" ("workspace_id", "href") WHERE "reusable";
+CREATE INDEX "links_deleted_at_idx" ON "links" ("deleted_at") WHERE "deleted_at" IS NOT NULL;
+CREATE INDEX "links_tags_idx" ON "links" USING GIN ("tags");
+CREATE INDEX "links_metadata_idx" ON "links" USING GIN ("metadata" jsonb_path_ops);
+CREATE INDEX "links_workspace_id_idx" ON "links" ("workspace_id", "id");
+
+CREATE TABLE IF NOT EXISTS "short_id_tombstones" (
+	"short_id" text NOT NULL,
+	"deleted_at" timestamp with time zone NOT NULL,
+	"purged_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"workspace_id" bigint NOT NULL,
+	PRIMARY KEY ("short_id")
+);
+
+CREATE TABLE IF NOT EXISTS "audit_log" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"link_id" bigint NOT NULL,
+	"short_id" text NOT NULL,
+	"action" text NOT NULL,
+	"actor_type" text NOT NULL,
+	"actor_id" text NOT NULL,
+	"old_value" jsonb,
+	"new_value" jsonb,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"workspace_id" bigint NOT NULL,
 	PRIMARY KEY ("id")
-);
\ No newline at end of file
+);
+CREATE INDEX "audit_log_short_id_idx" ON "audit_log" ("short_id", "id");
+CREATE INDEX "audit_log_actor_idx" ON "audit_log" ("actor_type", "actor_id", "id");
+CREATE INDEX "audit_log_created_at_idx" ON "audit_log" ("created_at");
+CREATE INDEX "audit_log_workspace_id_idx" ON "audit_log" ("workspace_id", "id");
+
+CREATE TABLE IF NOT EXISTS "link_clicks" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"link_id" bigint NOT NULL REFERENCES "links" ("id") ON DELETE CASCADE,
+	"variant" text,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"workspace_id" bigint NOT NULL,
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "link_clicks_link_id_idx" ON "link_clicks" ("link_id");
+
+CREATE TABLE IF NOT EXISTS "events" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"type" text NOT NULL,
+	"link_id" bigint NOT NULL,
+	"payload" jsonb NOT NULL,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"dispatched_at" timestamp with time zone,
+	"published_at" timestamp with time zone,
+	"workspace_id" bigint NOT NULL,
+	PRIMARY KEY ("id")
+);
+CREATE INDEX "events_undispatched_idx" ON "events" ("id") WHERE "dispatched_at" IS NULL;
+CREATE INDEX "events_unpublished_idx" ON "events" ("id") WHERE "published_at" IS NULL;
+
+CREATE TABLE IF NOT EXISTS "webhooks" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"url" text NOT NULL,
+	"secret" text NOT NULL,
+	"event_types" text[] NOT NULL DEFAULT '{}',
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"workspace_id" bigint NOT NULL REFERENCES "workspaces" ("id"),
+	PRIMARY KEY ("id")
+);
+
+CREATE INDEX "webhooks_workspace_id_idx" ON "webhooks" ("workspace_id", "id");
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+	"id" bigint GENERATED ALWAYS AS IDENTITY NOT NULL UNIQUE,
+	"webhook_id" bigint NOT NULL REFERENCES "webhooks" ("id") ON DELETE CASCADE,
+	"event_id" bigint NOT NULL REFERENCES "events" ("id") ON DELETE CASCADE,
+	"status" text NOT NULL DEFAULT 'pending',
+	"attempts" integer NOT NULL DEFAULT 0,
+	"next_attempt_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	"last_error" text,
+	"delivered_at" timestamp with time zone,
+	"created_at" timestamp with time zone NOT NULL DEFAULT NOW(),
+	PRIMARY KEY ("id"),
+	UNIQUE ("webhook_id", "event_id")
+);
+CREATE INDEX "webhook_deliveries_pending_idx" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'pending';
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kirillismad/go-url-shortener/internal/apps/links/entity"
+	links_usecase "github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/usecase"
+)
+
+// TestLinkRepoRoundTrip writes a link with every optional column and reads
+// it back through each query returning links, so that generated queries
+// and their Scan targets cannot drift apart unnoticed.
+func (s *IntegrationTestSuite) TestLinkRepoRoundTrip() {
+	r := s.Require()
+	ctx := context.Background()
+	linkRepo := s.linkRepoFactory.GetRepo()
+
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	tag := "it-" + suffix
+	passthrough := true
+	created, err := linkRepo.CreateLink(ctx, links_usecase.CreateLinkArgs{
+		WorkspaceID:    usecase.DefaultWorkspaceID,
+		ShortID:        "it" + suffix[len(suffix)-9:],
+		Href:           "https://example.com/it",
+		MaxClicks:      10,
+		Rules:          []entity.RedirectRule{{Languages: []string{"de"}, Href: "https://example.de"}},
+		Variants:       []entity.Variant{{Name: "a", Href: "https://a.example.com", Weight: 1}, {Name: "b", Href: "https://b.example.com", Weight: 1}},
+		StickyVariants: true,
+		QueryOptions:   entity.QueryOptions{Passthrough: &passthrough, Conflict: "override", Allowlist: []string{"utm_*"}},
+		Title:          "Integration",
+		Description:    "Round trip of every column",
+		Tags:           []string{tag, "integration"},
+		Metadata:       map[string]string{"campaign": suffix},
+	})
+	r.NoError(err)
+	r.Equal(usecase.DefaultWorkspaceID, created.WorkspaceID)
+	r.Equal([]string{tag, "integration"}, created.Tags)
+	r.Equal(map[string]string{"campaign": suffix}, created.Metadata)
+
+	byShortID, err := linkRepo.GetLinkByShortID(ctx, created.ShortID)
+	r.NoError(err)
+	r.Equal(created, byShortID)
+
+	inWorkspace, err := linkRepo.GetWorkspaceLinkByShortID(ctx, links_usecase.WorkspaceShortID{
+		WorkspaceID: usecase.DefaultWorkspaceID,
+		ShortID:     created.ShortID,
+	})
+	r.NoError(err)
+	r.Equal(created, inWorkspace)
+
+	listed, err := linkRepo.ListLinks(ctx, links_usecase.ListLinksArgs{
+		WorkspaceID: usecase.DefaultWorkspaceID,
+		Tags:        []string{tag},
+		Metadata:    map[string]string{"campaign": suffix},
+		Limit:       10,
+	})
+	r.NoError(err)
+	r.Equal([]entity.Link{created}, listed)
+
+	byShortIDs, err := linkRepo.ListLinksByShortIDs(ctx, []string{created.ShortID})
+	r.NoError(err)
+	r.Equal([]entity.Link{created}, byShortIDs)
+
+	title := "Integration, updated"
+	updated, err := linkRepo.UpdateLink(ctx, links_usecase.UpdateLinkArgs{
+		ID:             created.ID,
+		Href:           created.Href,
+		MaxClicks:      created.MaxClicks,
+		Rules:          created.Rules,
+		Variants:       created.Variants,
+		StickyVariants: created.StickyVariants,
+		QueryOptions:   created.QueryOptions,
+		Title:          title,
+		Description:    created.Description,
+		Tags:           []string{tag},
+		Metadata:       created.Metadata,
+	})
+	r.NoError(err)
+	r.Equal(title, updated.Title)
+	r.Equal([]string{tag}, updated.Tags)
+
+	tags, err := linkRepo.ListTags(ctx, usecase.DefaultWorkspaceID)
+	r.NoError(err)
+	r.Contains(tags, entity.TagCount{Tag: tag, Links: 1})
+
+	deleted, err := linkRepo.SoftDeleteLink(ctx, created.ID)
+	r.NoError(err)
+	r.Equal(updated.Metadata, deleted.Metadata)
+}
@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
@@ -10,11 +12,17 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/pgx"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5/pgxpool"
+	links_usecase "github.com/kirillismad/go-url-shortener/internal/apps/links/usecase"
+	"github.com/kirillismad/go-url-shortener/internal/pkg/repo"
 	"github.com/stretchr/testify/suite"
 )
 
 type IntegrationTestSuite struct {
 	suite.Suite
+
+	pool            *pgxpool.Pool
+	linkRepoFactory *repo.RepoFactory[links_usecase.LinkRepo]
 }
 
 // docker run --rm -p 5432:5432 -e POSTGRES_PASSWORD=pgpassword -e POSTGRES_USER=pguser -e POSTGRES_DB=testdb postgres:16
@@ -51,9 +59,19 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	}
 
 	err = migrator.Up()
-	if err != nil {
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
 		log.Fatalf("migrator.Up: %v", err)
 	}
+
+	s.pool, err = pgxpool.New(context.Background(), connString.String())
+	if err != nil {
+		log.Fatalf("pgxpool.New: %v", err)
+	}
+	s.linkRepoFactory = repo.NewRepoFactory(s.pool, repo.NewLinkRepo)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.pool.Close()
 }
 
 func TestIntegrationTestSuite(t *testing.T) {